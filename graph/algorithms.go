@@ -1,28 +1,52 @@
 package graph
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	simpleSt "graph/simplestructure"
 )
 
 // Determine if a graph is acyclic
-// Using topological sort
+// Using topological sort, a *CycleError holding one cycle is returned if not
 func IsAcyclic(g GraphInterface) error {
-	vertices, err := TopoSort(g)
-	if err != nil {
-		return err
-	}
+	_, err := TopoSort(g)
+	return err
+}
+
+// error for a cycle found in a graph which should be acyclic
+type CycleError struct {
+	// verteces in walking order, the last vertex goes back to the first one
+	Cycle []VertexInterface
+}
 
-	if len(vertices) < len(g.Verteces()) {
-		return fmt.Errorf("not acyclic.")
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("cycle found: %s", cycleString(e.Cycle))
+}
+
+// error for a graph which can not be topologically sorted without any cycle,
+// since undirected edges count as indegree of both endpoints
+var ErrUndirectedEdges = errors.New("undirected edges can not be topologically sorted!")
+
+// Format a cycle as 'a -> b -> a'
+func cycleString(cycle []VertexInterface) string {
+	names := make([]string, 0, len(cycle)+1)
+	for _, v := range cycle {
+		names = append(names, v.Name())
+	}
+	if len(cycle) > 0 {
+		names = append(names, cycle[0].Name())
 	}
 
-	return nil
+	return strings.Join(names, " -> ")
 }
 
 // Graph Topological Sort
-// If this graph is cyclic, the sorted vertices' number is less than the total vertices in grah
+// If this graph is cyclic, the sorted vertices' number is less than the total vertices in grah,
+// and a *CycleError holding one of the cycles is returned with them
+// Undirected edges count as indegree too, so a graph of them without cycle gets ErrUndirectedEdges
 func TopoSort(g GraphInterface) (sortVertexList []VertexInterface, err error) {
 	indgreeMap := make(map[string]int)
 	idQueue := simpleSt.NewSimpleQueue()
@@ -62,9 +86,68 @@ func TopoSort(g GraphInterface) (sortVertexList []VertexInterface, err error) {
 		}
 	}
 
+	if len(sortVertexList) < len(verteces) {
+		if cycle := FindCycle(g); cycle != nil {
+			return sortVertexList, &CycleError{Cycle: cycle}
+		}
+		return sortVertexList, ErrUndirectedEdges
+	}
+
 	return sortVertexList, nil
 }
 
+// Find one cycle of a graph by dfs, nil if the graph is acyclic
+// The cycle is given in walking order, and the last vertex goes back to the first one
+// An undirected edge is not walked back right away, so it does not make a cycle alone
+func FindCycle(g GraphInterface) []VertexInterface {
+	verteces, _ := indexVerteces(g)
+
+	// verteces on the current dfs path, and those fully explored
+	onPath := make(map[string]int)
+	done := make(map[string]bool)
+	var path []VertexInterface
+
+	var visit func(v VertexInterface, via EdgeInterface) []VertexInterface
+	visit = func(v VertexInterface, via EdgeInterface) []VertexInterface {
+		onPath[v.Name()] = len(path)
+		path = append(path, v)
+
+		for _, ei := range v.EdgesBackward() {
+			// do not go back through the undirected edge we came from
+			if via != nil && ei.Type() == UndirectedEdge && via.Type() == UndirectedEdge &&
+				adjacentVertex(v, ei).Name() == adjacentVertex(v, via).Name() {
+				continue
+			}
+
+			adj := adjacentVertex(v, ei)
+			if i, ok := onPath[adj.Name()]; ok {
+				return append([]VertexInterface{}, path[i:]...)
+			}
+
+			if !done[adj.Name()] {
+				if cycle := visit(adj, ei); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		delete(onPath, v.Name())
+		done[v.Name()] = true
+		return nil
+	}
+
+	for _, v := range verteces {
+		if !done[v.Name()] {
+			if cycle := visit(v, nil); cycle != nil {
+				return cycle
+			}
+		}
+	}
+
+	return nil
+}
+
 // Graph BFS
 // search start from a root vertex
 func BFS(g GraphInterface, executeFunc func(VertexInterface)) {
@@ -125,3 +208,79 @@ func DFSVertex(root VertexInterface, hasVisted map[string]bool, executeFunc func
 		}
 	}
 }
+
+// Get the other endpoint of an edge seen from vertex v
+// Backward edges always point from v, while an undirected edge stored in v may keep v as its 'to' vertex
+func adjacentVertex(v VertexInterface, ei EdgeInterface) VertexInterface {
+	if ei.From().Name() == v.Name() {
+		return ei.To()
+	}
+	return ei.From()
+}
+
+// Check that a vertex is not null and belongs to the graph
+func checkVertex(g GraphInterface, v VertexInterface) error {
+	if v == nil {
+		return fmt.Errorf("Input is null, please do check!")
+	}
+
+	if g.GetVertex(v.Name()) == nil {
+		return fmt.Errorf("vertex[name:%s] not exists in graph[name:%s]!", v.Name(), g.Name())
+	}
+
+	return nil
+}
+
+// Get a reversed copy of a vertex list
+func reverseVerteces(verteces []VertexInterface) []VertexInterface {
+	reversed := make([]VertexInterface, 0, len(verteces))
+	for i := len(verteces) - 1; i >= 0; i-- {
+		reversed = append(reversed, verteces[i])
+	}
+	return reversed
+}
+
+// Index verteces of a graph by the order of their names
+// It gives the algorithms working on arrays a stable vertex order
+func indexVerteces(g GraphInterface) ([]VertexInterface, map[string]int) {
+	verteces := make([]VertexInterface, 0, len(g.Verteces()))
+	for _, v := range g.Verteces() {
+		verteces = append(verteces, v)
+	}
+	sort.Slice(verteces, func(i, j int) bool {
+		return verteces[i].Name() < verteces[j].Name()
+	})
+
+	index := make(map[string]int, len(verteces))
+	for i, v := range verteces {
+		index[v.Name()] = i
+	}
+
+	return verteces, index
+}
+
+// List every edge of a graph once, ordered by the name of its 'from' vertex
+// An undirected edge is kept in both endpoints, only the copy stored in its 'from' vertex is listed
+// An undirected self loop keeps both copies in the same vertex, only the first one is listed
+func edgeList(g GraphInterface) []EdgeInterface {
+	verteces, _ := indexVerteces(g)
+
+	var edges []EdgeInterface
+	for _, v := range verteces {
+		loops := 0
+		for _, ei := range v.EdgesBackward() {
+			if ei.From().Name() != v.Name() {
+				continue
+			}
+			if ei.Type() == UndirectedEdge && ei.To().Name() == v.Name() {
+				loops++
+				if loops%2 == 0 {
+					continue
+				}
+			}
+			edges = append(edges, ei)
+		}
+	}
+
+	return edges
+}
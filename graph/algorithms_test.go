@@ -42,4 +42,87 @@ func Test4DFS(t *testing.T) {
 	}
 	DFS(g, f)
 	t.Log("data sum:", sum)
-}
\ No newline at end of file
+}
+
+func Test4FindCycle(t *testing.T) {
+	if cycle := FindCycle(createDirectedGraph4Test(t)); cycle != nil {
+		t.Errorf("directed test graph is acyclic, found %v", cycle)
+	}
+
+	g := createCyclicGraph4Test(t)
+	cycle := FindCycle(g)
+	if len(cycle) == 0 {
+		t.Fatal("cycle should be found")
+	}
+	checkCycle(t, cycle)
+
+	// an undirected edge alone is not a cycle, so a spanning forest has none
+	ug := createNetworkGraph4Test(t)
+	checkCycle(t, FindCycle(ug))
+	edges, _, _ := Kruskal(ug)
+	forest, err := NewSpanningGraph("forest", ug, edges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cycle := FindCycle(forest); cycle != nil {
+		t.Errorf("spanning forest is acyclic, found %v", cycle)
+	}
+}
+
+func Test4TopoSort_Cyclic(t *testing.T) {
+	g := createCyclicGraph4Test(t)
+
+	sorted, err := TopoSort(g)
+	cycleErr, ok := err.(*CycleError)
+	if !ok {
+		t.Fatalf("cycle error expected, got %v", err)
+	}
+	t.Log(cycleErr)
+	checkCycle(t, cycleErr.Cycle)
+
+	if len(sorted) >= len(g.Verteces()) {
+		t.Error("cyclic graph can not be fully sorted")
+	}
+
+	if IsAcyclic(g) == nil || IsAcyclic(createDirectedGraph4Test(t)) != nil {
+		t.Error("IsAcyclic is wrong")
+	}
+}
+
+func Test4TopoSort_UndirectedTree(t *testing.T) {
+	ug := createNetworkGraph4Test(t)
+	edges, _, _ := Kruskal(ug)
+	forest, err := NewSpanningGraph("forest", ug, edges)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// undirected edges can not be sorted, but no cycle is made up
+	if _, err := TopoSort(forest); err != ErrUndirectedEdges {
+		t.Errorf("ErrUndirectedEdges expected, got %v", err)
+	}
+	if IsAcyclic(forest) != ErrUndirectedEdges {
+		t.Error("IsAcyclic is wrong")
+	}
+}
+
+// check that every vertex of a cycle leads to the next one
+func checkCycle(t *testing.T, cycle []VertexInterface) {
+	if len(cycle) == 0 {
+		t.Error("cycle is empty")
+		return
+	}
+
+	for i, v := range cycle {
+		next := cycle[(i+1)%len(cycle)]
+		found := false
+		for _, ei := range v.EdgesBackward() {
+			if adjacentVertex(v, ei).Name() == next.Name() {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s does not lead to %s in cycle %s", v.Name(), next.Name(), cycleString(cycle))
+		}
+	}
+}
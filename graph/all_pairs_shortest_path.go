@@ -0,0 +1,162 @@
+package graph
+
+import (
+	"math"
+)
+
+/**********************************************************************************/
+// all-pairs shortest paths
+/**********************************************************************************/
+
+// shortest paths between every pair of verteces
+type AllPairsShortestPaths struct {
+	// single-source shortest paths keyed by the name of source vertex
+	Sources map[string]*ShortestPaths
+	// name-vertex map of the graph
+	verteces map[string]VertexInterface
+}
+
+// Get the distance between two verteces by name, +Inf if unreachable
+func (ap *AllPairsShortestPaths) Dist(src, dst string) float64 {
+	sp, ok := ap.Sources[src]
+	if !ok {
+		return math.Inf(1)
+	}
+
+	d, ok := sp.Dist[dst]
+	if !ok {
+		return math.Inf(1)
+	}
+	return d
+}
+
+// Reconstruct the shortest path between two verteces by name, nil if unreachable
+func (ap *AllPairsShortestPaths) Path(src, dst string) []VertexInterface {
+	sp, ok := ap.Sources[src]
+	if !ok {
+		return nil
+	}
+
+	v, ok := ap.verteces[dst]
+	if !ok {
+		return nil
+	}
+
+	return sp.PathTo(v)
+}
+
+func newAllPairsShortestPaths(g GraphInterface) *AllPairsShortestPaths {
+	return &AllPairsShortestPaths{
+		Sources:  make(map[string]*ShortestPaths),
+		verteces: g.Verteces(),
+	}
+}
+
+// Floyd-Warshall all-pairs shortest paths, suited for dense graphs
+// Negative weights are allowed, a negative cycle is reported as *NegativeCycleError
+func FloydWarshall(g GraphInterface) (*AllPairsShortestPaths, error) {
+	verteces, index := indexVerteces(g)
+	n := len(verteces)
+
+	// dist[i][j] and the predecessor index of j on the path from i
+	dist := make([][]float64, n)
+	prev := make([][]int, n)
+	for i := range verteces {
+		dist[i] = make([]float64, n)
+		prev[i] = make([]int, n)
+		for j := range verteces {
+			dist[i][j] = math.Inf(1)
+			prev[i][j] = -1
+		}
+		dist[i][i] = 0
+	}
+
+	for i, v := range verteces {
+		for _, ei := range v.EdgesBackward() {
+			j := index[adjacentVertex(v, ei).Name()]
+			if w := float64(ei.Weight()); w < dist[i][j] {
+				dist[i][j] = w
+				prev[i][j] = i
+			}
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if math.IsInf(dist[i][k], 1) {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if d := dist[i][k] + dist[k][j]; d < dist[i][j] {
+					dist[i][j] = d
+					prev[i][j] = prev[k][j]
+				}
+			}
+		}
+	}
+
+	for i, v := range verteces {
+		if dist[i][i] < 0 {
+			// let bellman-ford extract the cycle through this vertex
+			_, err := BellmanFord(g, v)
+			return nil, err
+		}
+	}
+
+	ap := newAllPairsShortestPaths(g)
+	for i, src := range verteces {
+		sp := &ShortestPaths{
+			Source: src,
+			Dist:   make(map[string]float64, n),
+			Prev:   make(map[string]VertexInterface),
+		}
+		for j, dst := range verteces {
+			sp.Dist[dst.Name()] = dist[i][j]
+			if i != j && prev[i][j] != -1 {
+				sp.Prev[dst.Name()] = verteces[prev[i][j]]
+			}
+		}
+		ap.Sources[src.Name()] = sp
+	}
+
+	return ap, nil
+}
+
+// Johnson all-pairs shortest paths, suited for sparse graphs
+// Weights are made non-negative by bellman-ford potentials, then dijkstra runs from every vertex
+// Negative weights are allowed, a negative cycle is reported as *NegativeCycleError
+func Johnson(g GraphInterface) (*AllPairsShortestPaths, error) {
+	// potentials: distances from a virtual source linked to every vertex by a zero-weight edge
+	h := &ShortestPaths{
+		Dist: make(map[string]float64),
+		Prev: make(map[string]VertexInterface),
+	}
+	for name := range g.Verteces() {
+		h.Dist[name] = 0
+	}
+	if err := bellmanFord(g, h, edgeWeight); err != nil {
+		return nil, err
+	}
+
+	reweight := func(from VertexInterface, ei EdgeInterface) float64 {
+		w := float64(ei.Weight()) + h.Dist[from.Name()] - h.Dist[adjacentVertex(from, ei).Name()]
+		// guard against rounding, the reweighted edge is never negative
+		if w < 0 {
+			return 0
+		}
+		return w
+	}
+
+	ap := newAllPairsShortestPaths(g)
+	for name, src := range g.Verteces() {
+		sp := dijkstra(g, src, reweight)
+		for dst, d := range sp.Dist {
+			if !math.IsInf(d, 1) {
+				sp.Dist[dst] = d - h.Dist[name] + h.Dist[dst]
+			}
+		}
+		ap.Sources[name] = sp
+	}
+
+	return ap, nil
+}
@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func Test4AllPairsShortestPaths(t *testing.T) {
+	g := createWeightedDirectedGraph4Test(t)
+	g.InsertEdgeByName("c", "e", NewEdge(2, BackwardEdge))
+	g.InsertEdgeByName("e", "b", NewEdge(-2, BackwardEdge))
+
+	floyd, err := FloydWarshall(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	johnson, err := Johnson(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every row must agree with bellman-ford
+	for name, src := range g.Verteces() {
+		sp, err := BellmanFord(g, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for dst, d := range sp.Dist {
+			if floyd.Dist(name, dst) != d {
+				t.Errorf("floyd-warshall dist %s -> %s is %v, expected %v", name, dst, floyd.Dist(name, dst), d)
+			}
+			if johnson.Dist(name, dst) != d {
+				t.Errorf("johnson dist %s -> %s is %v, expected %v", name, dst, johnson.Dist(name, dst), d)
+			}
+		}
+	}
+
+	checkPath(t, floyd.Path("a", "d"), "a", "c", "e", "b", "d")
+	checkPath(t, johnson.Path("a", "d"), "a", "c", "e", "b", "d")
+	checkPath(t, floyd.Path("b", "b"), "b")
+	if floyd.Path("a", "f") != nil || !math.IsInf(johnson.Dist("f", "a"), 1) {
+		t.Error("f should be isolated")
+	}
+}
+
+func Test4AllPairsShortestPaths_NegativeCycle(t *testing.T) {
+	g := createWeightedDirectedGraph4Test(t)
+	g.InsertEdgeByName("e", "b", NewEdge(-5, BackwardEdge))
+
+	if _, err := FloydWarshall(g); err != nil {
+		t.Log(err)
+	} else {
+		t.Error("floyd-warshall should report the negative cycle")
+	}
+
+	if _, err := Johnson(g); err != nil {
+		t.Log(err)
+	} else {
+		t.Error("johnson should report the negative cycle")
+	}
+}
@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	simpleSt "graph/simplestructure"
+)
+
+// A* search from src to dst, guided by heuristic h
+// h estimates the remaining cost from a vertex to dst, nil means no estimation (the same as dijkstra)
+// With an admissible heuristic the returned path is optimal
+// Return the path, its total cost and the number of expanded verteces
+func AStar(g GraphInterface, src, dst VertexInterface, h func(VertexInterface) float64) (
+	path []VertexInterface, cost float64, expanded int, err error) {
+	if err := checkVertex(g, src); err != nil {
+		return nil, math.Inf(1), 0, err
+	}
+
+	if err := checkVertex(g, dst); err != nil {
+		return nil, math.Inf(1), 0, err
+	}
+
+	if err := checkNonNegativeWeights(g, "A*"); err != nil {
+		return nil, math.Inf(1), 0, err
+	}
+
+	if h == nil {
+		h = func(VertexInterface) float64 { return 0 }
+	}
+
+	sp := newShortestPaths(g, src)
+	closed := make(map[string]bool)
+
+	pq := simpleSt.NewSimplePriorityQueue()
+	pq.Push(src, h(src))
+	for {
+		item := pq.Pop()
+		if item == nil {
+			break
+		}
+		v := item.(VertexInterface)
+		if closed[v.Name()] {
+			continue
+		}
+		closed[v.Name()] = true
+		expanded++
+
+		if v.Name() == dst.Name() {
+			return sp.PathTo(dst), sp.Dist[dst.Name()], expanded, nil
+		}
+
+		for _, ei := range v.EdgesBackward() {
+			adj := adjacentVertex(v, ei)
+			if d := sp.Dist[v.Name()] + float64(ei.Weight()); d < sp.Dist[adj.Name()] {
+				sp.Dist[adj.Name()] = d
+				sp.Prev[adj.Name()] = v
+				// reopen the vertex, an inconsistent heuristic may close it too early
+				delete(closed, adj.Name())
+				pq.Push(adj, d+h(adj))
+			}
+		}
+	}
+
+	return nil, math.Inf(1), expanded, fmt.Errorf("vertex[name:%s] is unreachable from vertex[name:%s]!", dst.Name(), src.Name())
+}
@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func Test4AStar(t *testing.T) {
+	g := createGridGraph4Test(t, 6)
+	src, dst := g.GetVertex("0_0"), g.GetVertex("5_5")
+
+	manhattan := func(v VertexInterface) float64 {
+		p := v.Data().([2]int)
+		return float64(5-p[0]) + float64(5-p[1])
+	}
+
+	path, cost, expanded, err := AStar(g, src, dst, manhattan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("cost:%v, expanded:%d", cost, expanded)
+
+	sp, err := Dijkstra(g, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost != sp.DistTo(dst) {
+		t.Errorf("cost is %v, expected %v", cost, sp.DistTo(dst))
+	}
+	if len(path) != 11 || path[0].Name() != "0_0" || path[10].Name() != "5_5" {
+		t.Errorf("unexpected path %v", path)
+	}
+	if expanded >= len(g.Verteces()) {
+		t.Errorf("heuristic should reduce expanded verteces, expanded %d", expanded)
+	}
+
+	// without heuristic every vertex closer than dst gets expanded
+	_, plainCost, plainExpanded, _ := AStar(g, src, dst, nil)
+	if plainCost != cost || plainExpanded <= expanded {
+		t.Errorf("plain search cost:%v, expanded:%d", plainCost, plainExpanded)
+	}
+}
+
+func Test4AStar_Unreachable(t *testing.T) {
+	g := createGridGraph4Test(t, 3)
+	g.InsertVertex(NewVertex("island", [2]int{9, 9}))
+
+	path, cost, _, err := AStar(g, g.GetVertex("0_0"), g.GetVertex("island"), nil)
+	if err == nil || path != nil || !math.IsInf(cost, 1) {
+		t.Error("island should be unreachable")
+	}
+	t.Log(err)
+}
+
+/// create a size x size grid graph for test
+// vertex 'x_y' holds its coordinate, moving right costs 1 and moving down costs 1.5 except on the last column
+func createGridGraph4Test(t *testing.T, size int) *UndirectedGraph {
+	g := NewUndirectedGraph("GridGraph")
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			if g.InsertVertex(NewVertex(fmt.Sprintf("%d_%d", x, y), [2]int{x, y})) != nil {
+				t.Error("InsertVertex error")
+			}
+		}
+	}
+
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			name := fmt.Sprintf("%d_%d", x, y)
+			if x+1 < size {
+				if g.InsertEdgeByName(name, fmt.Sprintf("%d_%d", x+1, y), NewEdge(1, UndirectedEdge)) != nil {
+					t.Error("InsertEdge error")
+				}
+			}
+			if y+1 < size {
+				w := float32(1.5)
+				if x == size-1 {
+					w = 1
+				}
+				if g.InsertEdgeByName(name, fmt.Sprintf("%d_%d", x, y+1), NewEdge(w, UndirectedEdge)) != nil {
+					t.Error("InsertEdge error")
+				}
+			}
+		}
+	}
+
+	return g
+}
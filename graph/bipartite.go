@@ -0,0 +1,177 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	simpleSt "graph/simplestructure"
+)
+
+/**********************************************************************************/
+// bipartite graph
+/**********************************************************************************/
+
+// Determine if a graph is bipartite by bfs two-coloring, edges are walked both ways
+// Return the color (0 or 1) of each vertex name if it is,
+// or an odd cycle proving it is not, in walking order where the last vertex goes back to the first one
+func IsBipartite(g GraphInterface) (colors map[string]int, oddCycle []VertexInterface, ok bool) {
+	verteces, _ := indexVerteces(g)
+	colors = make(map[string]int)
+	parent := make(map[string]VertexInterface)
+
+	for _, root := range verteces {
+		if _, ok := colors[root.Name()]; ok {
+			continue
+		}
+
+		colors[root.Name()] = 0
+		queue := simpleSt.NewSimpleQueue()
+		queue.Pushback(root)
+		for {
+			item := queue.Popfront()
+			if item == nil {
+				break
+			}
+			v := item.(VertexInterface)
+			for _, ei := range v.Edges() {
+				adj := adjacentVertex(v, ei)
+				c, ok := colors[adj.Name()]
+				if !ok {
+					colors[adj.Name()] = 1 - colors[v.Name()]
+					parent[adj.Name()] = v
+					queue.Pushback(adj)
+				} else if c == colors[v.Name()] {
+					return nil, oddCycleOf(v, adj, parent), false
+				}
+			}
+		}
+	}
+
+	return colors, nil, true
+}
+
+// Build the odd cycle closed by edge u-w between two verteces of the same bfs color
+func oddCycleOf(u, w VertexInterface, parent map[string]VertexInterface) []VertexInterface {
+	// paths from both ends up to the bfs root
+	var pathU, pathW []VertexInterface
+	for v := u; v != nil; v = parent[v.Name()] {
+		pathU = append(pathU, v)
+	}
+	for v := w; v != nil; v = parent[v.Name()] {
+		pathW = append(pathW, v)
+	}
+
+	// drop the common part above the lowest common ancestor
+	i, j := len(pathU)-1, len(pathW)-1
+	for i > 0 && j > 0 && pathU[i-1].Name() == pathW[j-1].Name() {
+		i--
+		j--
+	}
+
+	// u ... lca, then back down to w
+	cycle := append([]VertexInterface{}, pathU[:i+1]...)
+	return append(cycle, reverseVerteces(pathW[:j])...)
+}
+
+/**********************************************************************************/
+// maximum bipartite matching
+/**********************************************************************************/
+
+// Hopcroft-Karp maximum matching of a bipartite graph
+// Return the matched edges, or an error with an odd cycle if the graph is not bipartite
+func HopcroftKarp(g GraphInterface) ([]EdgeInterface, error) {
+	colors, oddCycle, ok := IsBipartite(g)
+	if !ok {
+		return nil, fmt.Errorf("graph[name:%s] is not bipartite, odd cycle: %s", g.Name(), cycleString(oddCycle))
+	}
+
+	verteces, index := indexVerteces(g)
+	// left side holds the verteces of color 0, edges to the right side are kept per left vertex
+	var left []int
+	adj := make([][]int, len(verteces))
+	edges := make(map[[2]int]EdgeInterface)
+	for i, v := range verteces {
+		if colors[v.Name()] != 0 {
+			continue
+		}
+		left = append(left, i)
+		for _, ei := range v.Edges() {
+			j := index[adjacentVertex(v, ei).Name()]
+			if _, ok := edges[[2]int{i, j}]; !ok {
+				edges[[2]int{i, j}] = ei
+				adj[i] = append(adj[i], j)
+			}
+		}
+	}
+
+	match := make([]int, len(verteces))
+	for i := range match {
+		match[i] = -1
+	}
+	dist := make([]float64, len(verteces))
+
+	// bfs layers from free left verteces, true if some free right vertex is reached
+	bfs := func() bool {
+		queue := simpleSt.NewSimpleQueue()
+		for _, u := range left {
+			if match[u] == -1 {
+				dist[u] = 0
+				queue.Pushback(u)
+			} else {
+				dist[u] = math.Inf(1)
+			}
+		}
+
+		found := false
+		for {
+			item := queue.Popfront()
+			if item == nil {
+				break
+			}
+			u := item.(int)
+			for _, v := range adj[u] {
+				w := match[v]
+				if w == -1 {
+					found = true
+				} else if math.IsInf(dist[w], 1) {
+					dist[w] = dist[u] + 1
+					queue.Pushback(w)
+				}
+			}
+		}
+
+		return found
+	}
+
+	// augment along the layers by dfs
+	var dfs func(u int) bool
+	dfs = func(u int) bool {
+		for _, v := range adj[u] {
+			w := match[v]
+			if w == -1 || (dist[w] == dist[u]+1 && dfs(w)) {
+				match[u] = v
+				match[v] = u
+				return true
+			}
+		}
+		dist[u] = math.Inf(1)
+		return false
+	}
+
+	for bfs() {
+		for _, u := range left {
+			if match[u] == -1 {
+				dfs(u)
+			}
+		}
+	}
+
+	var matching []EdgeInterface
+	for _, u := range left {
+		if match[u] != -1 {
+			matching = append(matching, edges[[2]int{u, match[u]}])
+		}
+	}
+
+	return matching, nil
+}
@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"testing"
+)
+
+func Test4IsBipartite(t *testing.T) {
+	g := createJobGraph4Test(t)
+
+	colors, _, ok := IsBipartite(g)
+	if !ok {
+		t.Fatal("job graph is bipartite")
+	}
+	for _, v := range g.Verteces() {
+		for _, ei := range v.Edges() {
+			if colors[v.Name()] == colors[adjacentVertex(v, ei).Name()] {
+				t.Errorf("%s and %s have the same color", v.Name(), adjacentVertex(v, ei).Name())
+			}
+		}
+	}
+
+	_, oddCycle, ok := IsBipartite(createNetworkGraph4Test(t))
+	if ok {
+		t.Fatal("network graph has triangles")
+	}
+	t.Log(cycleString(oddCycle))
+	if len(oddCycle)%2 != 1 {
+		t.Errorf("cycle %s is not odd", cycleString(oddCycle))
+	}
+	checkCycle(t, oddCycle)
+}
+
+func Test4HopcroftKarp(t *testing.T) {
+	g := createJobGraph4Test(t)
+
+	matching, err := HopcroftKarp(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matching) != 4 {
+		t.Errorf("matching size is %d, expected 4", len(matching))
+	}
+
+	matched := make(map[string]bool)
+	for _, ei := range matching {
+		t.Logf("%s - %s", ei.From().Name(), ei.To().Name())
+		if matched[ei.From().Name()] || matched[ei.To().Name()] {
+			t.Errorf("edge %s - %s shares a vertex", ei.From().Name(), ei.To().Name())
+		}
+		matched[ei.From().Name()] = true
+		matched[ei.To().Name()] = true
+	}
+
+	if _, err := HopcroftKarp(createNetworkGraph4Test(t)); err != nil {
+		t.Log(err)
+	} else {
+		t.Error("network graph is not bipartite")
+	}
+}
+
+/// create worker-job graph for test
+// w1: j1 j2, w2: j1, w3: j2 j3 j4, w4: j3
+func createJobGraph4Test(t *testing.T) *UndirectedGraph {
+	g := NewUndirectedGraph("JobGraph")
+	for _, name := range []string{"w1", "w2", "w3", "w4", "j1", "j2", "j3", "j4"} {
+		if g.InsertVertex(NewVertex(name, 0)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	if g.InsertEdgeByName("w1", "j1", NewEdge(3, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("w1", "j2", NewEdge(2, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("w2", "j1", NewEdge(4, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("w3", "j2", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("w3", "j3", NewEdge(5, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("w3", "j4", NewEdge(2, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("w4", "j3", NewEdge(3, UndirectedEdge)) != nil {
+		t.Error("InsertEdge error")
+	}
+
+	return g
+}
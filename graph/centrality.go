@@ -0,0 +1,211 @@
+package graph
+
+import (
+	"math"
+
+	simpleSt "graph/simplestructure"
+)
+
+/**********************************************************************************/
+// centrality
+/**********************************************************************************/
+
+// Degree centrality: the number of edges of each vertex, in and out for directed graph
+// If normalized, degrees are divided by n-1
+func DegreeCentrality(g GraphInterface, normalized bool) map[string]float64 {
+	n := len(g.Verteces())
+	centrality := make(map[string]float64, n)
+	for name, v := range g.Verteces() {
+		centrality[name] = float64(len(v.Edges()))
+		if normalized && n > 1 {
+			centrality[name] /= float64(n - 1)
+		}
+	}
+
+	return centrality
+}
+
+// Brandes betweenness centrality: how many shortest paths go through each vertex
+// Shortest paths follow edge weights if weighted is set, hop counts otherwise
+// Paths of an undirected graph are counted once per pair of endpoints
+// If normalized, scores are divided by the number of pairs of other verteces
+func BetweennessCentrality(g GraphInterface, weighted, normalized bool) (map[string]float64, error) {
+	if weighted {
+		if err := checkNonNegativeWeights(g, "betweenness centrality"); err != nil {
+			return nil, err
+		}
+	}
+
+	verteces, index := indexVerteces(g)
+	adj := weightedAdjacency(verteces, index, weighted)
+	n := len(verteces)
+	betweenness := make([]float64, n)
+
+	for s := 0; s < n; s++ {
+		// single-source shortest paths, counting them and recording predecessors
+		dist := make([]float64, n)
+		sigma := make([]float64, n)
+		preds := make([][]int, n)
+		for i := range dist {
+			dist[i] = math.Inf(1)
+		}
+		dist[s] = 0
+		sigma[s] = 1
+
+		// verteces by non-decreasing distance
+		var order []int
+		done := make([]bool, n)
+		pq := simpleSt.NewSimplePriorityQueue()
+		pq.Push(s, 0)
+		for {
+			item := pq.Pop()
+			if item == nil {
+				break
+			}
+			v := item.(int)
+			if done[v] {
+				continue
+			}
+			done[v] = true
+			order = append(order, v)
+
+			for _, arc := range adj[v] {
+				d := dist[v] + arc.weight
+				switch {
+				case d < dist[arc.to]:
+					dist[arc.to] = d
+					sigma[arc.to] = sigma[v]
+					preds[arc.to] = []int{v}
+					pq.Push(arc.to, d)
+				case d == dist[arc.to] && !done[arc.to]:
+					sigma[arc.to] += sigma[v]
+					preds[arc.to] = append(preds[arc.to], v)
+				}
+			}
+		}
+
+		// accumulate dependencies backward
+		delta := make([]float64, n)
+		for i := len(order) - 1; i >= 0; i-- {
+			w := order[i]
+			for _, v := range preds[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if w != s {
+				betweenness[w] += delta[w]
+			}
+		}
+	}
+
+	scale := 1.0
+	switch {
+	case normalized && n > 2:
+		// an undirected graph counts each pair twice, and has half as many pairs
+		scale = 1 / float64((n-1)*(n-2))
+	case checkUndirectedEdges(g, "betweenness centrality") == nil:
+		// each pair was counted from both ends
+		scale = 0.5
+	}
+
+	centrality := make(map[string]float64, n)
+	for i, v := range verteces {
+		centrality[v.Name()] = betweenness[i] * scale
+	}
+
+	return centrality, nil
+}
+
+// Closeness centrality: reachable verteces over the total distance to reach them from each vertex
+// Distances follow edge weights if weighted is set, hop counts otherwise
+// If normalized, scores are scaled by the fraction of other verteces reachable (Wasserman-Faust)
+func ClosenessCentrality(g GraphInterface, weighted, normalized bool) (map[string]float64, error) {
+	return distanceCentrality(g, weighted, func(dist []float64, source int) float64 {
+		n := len(dist)
+		reached, total := 0, 0.0
+		for j, d := range dist {
+			if j != source && !math.IsInf(d, 1) {
+				reached++
+				total += d
+			}
+		}
+
+		if total == 0 {
+			return 0
+		}
+		closeness := float64(reached) / total
+		if normalized && n > 1 {
+			closeness *= float64(reached) / float64(n-1)
+		}
+		return closeness
+	}, "closeness centrality")
+}
+
+// Harmonic centrality: the sum of inverse distances from each vertex to the others
+// Distances follow edge weights if weighted is set, hop counts otherwise
+// If normalized, scores are divided by n-1
+// A vertex reached over zero weight edges is at distance 0, which makes the score +Inf
+func HarmonicCentrality(g GraphInterface, weighted, normalized bool) (map[string]float64, error) {
+	return distanceCentrality(g, weighted, func(dist []float64, source int) float64 {
+		n := len(dist)
+		harmonic := 0.0
+		for j, d := range dist {
+			if j != source && !math.IsInf(d, 1) {
+				harmonic += 1 / d
+			}
+		}
+
+		if normalized && n > 1 {
+			harmonic /= float64(n - 1)
+		}
+		return harmonic
+	}, "harmonic centrality")
+}
+
+// Compute a score of each vertex from its distances to every vertex, indexed by name order
+func distanceCentrality(g GraphInterface, weighted bool, score func(dist []float64, source int) float64,
+	algorithm string) (map[string]float64, error) {
+	if weighted {
+		if err := checkNonNegativeWeights(g, algorithm); err != nil {
+			return nil, err
+		}
+	}
+
+	verteces, index := indexVerteces(g)
+	weight := func(from VertexInterface, ei EdgeInterface) float64 { return 1 }
+	if weighted {
+		weight = edgeWeight
+	}
+
+	centrality := make(map[string]float64, len(verteces))
+	for i, v := range verteces {
+		sp := dijkstra(g, v, weight)
+		dist := make([]float64, len(verteces))
+		for name, d := range sp.Dist {
+			dist[index[name]] = d
+		}
+		centrality[v.Name()] = score(dist, i)
+	}
+
+	return centrality, nil
+}
+
+// arc to an indexed vertex
+type weightedArc struct {
+	to     int
+	weight float64
+}
+
+// Build the weighted successor lists of indexed verteces, every weight is 1 if not weighted
+func weightedAdjacency(verteces []VertexInterface, index map[string]int, weighted bool) [][]weightedArc {
+	adj := make([][]weightedArc, len(verteces))
+	for i, v := range verteces {
+		for _, ei := range v.EdgesBackward() {
+			w := 1.0
+			if weighted {
+				w = float64(ei.Weight())
+			}
+			adj[i] = append(adj[i], weightedArc{to: index[adjacentVertex(v, ei).Name()], weight: w})
+		}
+	}
+	return adj
+}
@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func Test4DegreeCentrality(t *testing.T) {
+	g := createPathGraph4Test(t)
+
+	checkScores(t, "degree", DegreeCentrality(g, false), map[string]float64{"a": 1, "b": 2, "c": 2, "e": 1})
+	checkScores(t, "normalized degree", DegreeCentrality(g, true), map[string]float64{"a": 0.25, "c": 0.5})
+}
+
+func Test4BetweennessCentrality(t *testing.T) {
+	g := createPathGraph4Test(t)
+
+	scores, err := BetweennessCentrality(g, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkScores(t, "betweenness", scores, map[string]float64{"a": 0, "b": 3, "c": 4, "d": 3, "e": 0})
+
+	scores, _ = BetweennessCentrality(g, false, true)
+	checkScores(t, "normalized betweenness", scores, map[string]float64{"b": 0.5, "c": 4.0 / 6})
+
+	// square a-b-c-d-a where d-a is too heavy for weighted paths
+	square := NewUndirectedGraph("SquareGraph")
+	for _, name := range []string{"a", "b", "c", "d"} {
+		square.InsertVertex(NewVertex(name, 0))
+	}
+	square.InsertEdgeByName("a", "b", NewEdge(1, UndirectedEdge))
+	square.InsertEdgeByName("b", "c", NewEdge(1, UndirectedEdge))
+	square.InsertEdgeByName("c", "d", NewEdge(1, UndirectedEdge))
+	square.InsertEdgeByName("d", "a", NewEdge(5, UndirectedEdge))
+
+	scores, _ = BetweennessCentrality(square, false, false)
+	checkScores(t, "unweighted betweenness", scores, map[string]float64{"a": 0.5, "b": 0.5, "c": 0.5, "d": 0.5})
+	scores, _ = BetweennessCentrality(square, true, false)
+	checkScores(t, "weighted betweenness", scores, map[string]float64{"a": 0, "b": 2, "c": 2, "d": 0})
+
+	// directed paths are counted once, node0 reaches node3 by three paths and one goes through node7 and node5
+	dg := createDirectedGraph4Test(t)
+	scores, _ = BetweennessCentrality(dg, false, false)
+	checkScores(t, "directed betweenness", scores, map[string]float64{"node0": 0, "node7": 10.0 / 3, "node5": 10.0 / 3, "node3": 0})
+}
+
+func Test4ClosenessAndHarmonicCentrality(t *testing.T) {
+	g := createPathGraph4Test(t)
+
+	scores, err := ClosenessCentrality(g, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkScores(t, "closeness", scores, map[string]float64{"a": 0.4, "c": 4.0 / 6})
+
+	scores, _ = HarmonicCentrality(g, false, true)
+	checkScores(t, "harmonic", scores, map[string]float64{"c": 0.75})
+
+	// directed a -> b -> c: b reaches only half of the others
+	dg := NewDirectedGraph("ChainGraph")
+	for _, name := range []string{"a", "b", "c"} {
+		dg.InsertVertex(NewVertex(name, 0))
+	}
+	dg.InsertEdgeByName("a", "b", NewEdge(2, BackwardEdge))
+	dg.InsertEdgeByName("b", "c", NewEdge(2, BackwardEdge))
+
+	scores, _ = ClosenessCentrality(dg, false, true)
+	checkScores(t, "directed closeness", scores, map[string]float64{"a": 2.0 / 3, "b": 0.5, "c": 0})
+	scores, _ = ClosenessCentrality(dg, true, false)
+	checkScores(t, "weighted closeness", scores, map[string]float64{"a": 1.0 / 3, "b": 0.5})
+
+	if _, err := HarmonicCentrality(createWeightedDirectedGraph4Test(t), true, false); err != nil {
+		t.Error(err)
+	}
+
+	// a zero weight edge still reaches its vertex
+	dg.GetVertex("a").FindEdge(dg.GetVertex("b"), BackwardEdge).SetWeight(0)
+	scores, _ = ClosenessCentrality(dg, true, true)
+	checkScores(t, "zero weight closeness", scores, map[string]float64{"a": 1, "b": 0.25})
+	scores, _ = HarmonicCentrality(dg, true, false)
+	if !math.IsInf(scores["a"], 1) {
+		t.Errorf("harmonic of a is %v, expected +Inf", scores["a"])
+	}
+}
+
+// check scores of some verteces
+func checkScores(t *testing.T, name string, scores, expected map[string]float64) {
+	for v, e := range expected {
+		if math.Abs(scores[v]-e) > 1e-9 {
+			t.Errorf("%s of %s is %v, expected %v", name, v, scores[v], e)
+		}
+	}
+}
+
+/// create undirected path graph a-b-c-d-e for test
+func createPathGraph4Test(t *testing.T) *UndirectedGraph {
+	g := NewUndirectedGraph("PathGraph")
+	names := []string{"a", "b", "c", "d", "e"}
+	for _, name := range names {
+		if g.InsertVertex(NewVertex(name, 0)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	for i := 0; i+1 < len(names); i++ {
+		if g.InsertEdgeByName(names[i], names[i+1], NewEdge(1, UndirectedEdge)) != nil {
+			t.Error("InsertEdge error")
+		}
+	}
+
+	return g
+}
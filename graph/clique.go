@@ -0,0 +1,116 @@
+package graph
+
+import (
+	"sort"
+)
+
+/**********************************************************************************/
+// cliques
+/**********************************************************************************/
+
+// Enumerate maximal cliques by Bron-Kerbosch with pivoting, streaming each clique to fn
+// Edges are walked both ways and self-loops are ignored, clique members are ordered by name
+// fn returns false to stop the enumeration
+// Return the number of cliques passed to fn
+func MaximalCliques(g GraphInterface, fn func(clique []VertexInterface) bool) int {
+	verteces, index := indexVerteces(g)
+	if len(verteces) == 0 {
+		return 0
+	}
+
+	neighbours := neighbourSets(verteces, index)
+	for i := range neighbours {
+		delete(neighbours[i], i)
+	}
+
+	count := 0
+	stopped := false
+
+	var expand func(clique []int, candidates, excluded map[int]bool)
+	expand = func(clique []int, candidates, excluded map[int]bool) {
+		if len(candidates) == 0 {
+			if len(excluded) == 0 {
+				members := make([]VertexInterface, 0, len(clique))
+				for _, i := range sortedInts(clique) {
+					members = append(members, verteces[i])
+				}
+				count++
+				if !fn(members) {
+					stopped = true
+				}
+			}
+			return
+		}
+
+		// the pivot covers the most candidates, only its non-neighbours need branching
+		pivot, covered := -1, -1
+		for _, set := range []map[int]bool{candidates, excluded} {
+			for u := range set {
+				c := 0
+				for v := range candidates {
+					if neighbours[u][v] {
+						c++
+					}
+				}
+				if c > covered || (c == covered && u < pivot) {
+					pivot, covered = u, c
+				}
+			}
+		}
+
+		var branches []int
+		for v := range candidates {
+			if !neighbours[pivot][v] {
+				branches = append(branches, v)
+			}
+		}
+
+		for _, v := range sortedInts(branches) {
+			if stopped {
+				return
+			}
+
+			nextCandidates := make(map[int]bool)
+			nextExcluded := make(map[int]bool)
+			for w := range neighbours[v] {
+				if candidates[w] {
+					nextCandidates[w] = true
+				}
+				if excluded[w] {
+					nextExcluded[w] = true
+				}
+			}
+			expand(append(clique, v), nextCandidates, nextExcluded)
+
+			delete(candidates, v)
+			excluded[v] = true
+		}
+	}
+
+	candidates := make(map[int]bool)
+	for i := range verteces {
+		candidates[i] = true
+	}
+	expand(nil, candidates, make(map[int]bool))
+
+	return count
+}
+
+// Find a clique with the most verteces, ordered by name
+func MaximumClique(g GraphInterface) []VertexInterface {
+	var best []VertexInterface
+	MaximalCliques(g, func(clique []VertexInterface) bool {
+		if len(clique) > len(best) {
+			best = clique
+		}
+		return true
+	})
+
+	return best
+}
+
+func sortedInts(list []int) []int {
+	sorted := append([]int{}, list...)
+	sort.Ints(sorted)
+	return sorted
+}
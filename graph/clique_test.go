@@ -0,0 +1,48 @@
+package graph
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+func Test4MaximalCliques(t *testing.T) {
+	g := createNetworkGraph4Test(t)
+	// a, b, c and d form a K4 together with the existing triangle
+	g.InsertEdgeByName("a", "d", NewEdge(1, UndirectedEdge))
+	g.InsertEdgeByName("b", "d", NewEdge(1, UndirectedEdge))
+
+	var cliques []string
+	count := MaximalCliques(g, func(clique []VertexInterface) bool {
+		var names []string
+		for _, v := range clique {
+			names = append(names, v.Name())
+		}
+		cliques = append(cliques, strings.Join(names, ""))
+		return true
+	})
+	sort.Strings(cliques)
+	t.Log(cliques)
+
+	expected := "abcd def gh i"
+	if count != 4 || strings.Join(cliques, " ") != expected {
+		t.Errorf("cliques are %v, expected %s", cliques, expected)
+	}
+
+	if clique := MaximumClique(g); len(clique) != 4 || clique[0].Name() != "a" {
+		t.Errorf("maximum clique is %v", clique)
+	}
+
+	stopped := MaximalCliques(g, func(clique []VertexInterface) bool { return false })
+	if stopped != 1 {
+		t.Errorf("callback should stop the enumeration, called %d times", stopped)
+	}
+
+	empty := NewUndirectedGraph("Empty")
+	if count := MaximalCliques(empty, func(clique []VertexInterface) bool { return true }); count != 0 {
+		t.Errorf("empty graph has no clique, found %d", count)
+	}
+	if clique := MaximumClique(empty); clique != nil {
+		t.Errorf("maximum clique of empty graph should be nil, got %v", clique)
+	}
+}
@@ -0,0 +1,269 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+/**********************************************************************************/
+// graph coloring
+/**********************************************************************************/
+
+// Greedy coloring in vertex name order
+// Each vertex takes the smallest color not used by its neighbours, edges are walked both ways
+// Return the color of each vertex name, colors start from 0, and the number of colors
+// A vertex with a self-loop can not be colored, which is an error for every coloring below
+func GreedyColoring(g GraphInterface) (map[string]int, int, error) {
+	verteces, neighbours, err := coloringNeighbours(g)
+	if err != nil {
+		return nil, 0, err
+	}
+	order := make([]int, len(verteces))
+	for i := range order {
+		order[i] = i
+	}
+
+	colors, count := greedyColoring(verteces, neighbours, order)
+	return colors, count, nil
+}
+
+// Welsh-Powell coloring: greedy coloring by decreasing degree
+// Return the color of each vertex name and the number of colors, or an error for a self-loop
+func WelshPowellColoring(g GraphInterface) (map[string]int, int, error) {
+	verteces, neighbours, err := coloringNeighbours(g)
+	if err != nil {
+		return nil, 0, err
+	}
+	order := make([]int, len(verteces))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(neighbours[order[i]]) > len(neighbours[order[j]])
+	})
+
+	colors, count := greedyColoring(verteces, neighbours, order)
+	return colors, count, nil
+}
+
+// DSatur coloring: always color the vertex seeing the most distinct colors, ties broken by degree
+// Return the color of each vertex name and the number of colors, or an error for a self-loop
+func DSaturColoring(g GraphInterface) (map[string]int, int, error) {
+	verteces, neighbours, err := coloringNeighbours(g)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	colors, count := dsaturColoring(verteces, neighbours)
+	return colors, count, nil
+}
+
+// DSatur coloring of indexed verteces
+func dsaturColoring(verteces []VertexInterface, neighbours []map[int]bool) (map[string]int, int) {
+	n := len(verteces)
+
+	colors := make([]int, n)
+	// distinct colors among the neighbours of each vertex
+	saturation := make([]map[int]bool, n)
+	for i := range colors {
+		colors[i] = -1
+		saturation[i] = make(map[int]bool)
+	}
+
+	count := 0
+	for step := 0; step < n; step++ {
+		next := -1
+		for v := 0; v < n; v++ {
+			if colors[v] != -1 {
+				continue
+			}
+			if next == -1 || len(saturation[v]) > len(saturation[next]) ||
+				(len(saturation[v]) == len(saturation[next]) && len(neighbours[v]) > len(neighbours[next])) {
+				next = v
+			}
+		}
+
+		c := 0
+		for saturation[next][c] {
+			c++
+		}
+		colors[next] = c
+		if c+1 > count {
+			count = c + 1
+		}
+		for w := range neighbours[next] {
+			saturation[w][c] = true
+		}
+	}
+
+	return colorMap(verteces, colors), count
+}
+
+// Exact coloring with the chromatic number by backtracking, meant for small graphs
+// The search starts from the DSatur coloring and looks for colorings with fewer colors
+// If timeout (positive) expires, the best coloring found so far is returned with an error
+// Return the color of each vertex name and the number of colors, or an error for a self-loop
+func ChromaticNumber(g GraphInterface, timeout time.Duration) (map[string]int, int, error) {
+	verteces, neighbours, err := coloringNeighbours(g)
+	if err != nil {
+		return nil, 0, err
+	}
+	n := len(verteces)
+
+	best, count := dsaturColoring(verteces, neighbours)
+	if n == 0 {
+		return best, count, nil
+	}
+
+	// color verteces by decreasing degree, which prunes the search early
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(neighbours[order[i]]) > len(neighbours[order[j]])
+	})
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	timedOut := false
+	steps := 0
+
+	colors := make([]int, n)
+	for i := range colors {
+		colors[i] = -1
+	}
+	// try to color order[pos:] with at most k colors, used colors so far
+	var search func(pos, used, k int) bool
+	search = func(pos, used, k int) bool {
+		if pos == n {
+			return true
+		}
+
+		steps++
+		if timeout > 0 && steps%1024 == 0 && time.Now().After(deadline) {
+			timedOut = true
+		}
+		if timedOut {
+			return false
+		}
+
+		v := order[pos]
+		// a new color is only tried once, as all unused colors are symmetric
+		limit := used + 1
+		if limit > k {
+			limit = k
+		}
+		for c := 0; c < limit; c++ {
+			conflict := false
+			for w := range neighbours[v] {
+				if colors[w] == c {
+					conflict = true
+					break
+				}
+			}
+			if conflict {
+				continue
+			}
+
+			colors[v] = c
+			nextUsed := used
+			if c == used {
+				nextUsed++
+			}
+			if search(pos+1, nextUsed, k) {
+				return true
+			}
+		}
+
+		colors[v] = -1
+		return false
+	}
+
+	for k := count - 1; k >= 1; k-- {
+		if !search(0, 0, k) {
+			break
+		}
+		best, count = colorMap(verteces, colors), k
+		for i := range colors {
+			colors[i] = -1
+		}
+	}
+
+	if timedOut {
+		return best, count, fmt.Errorf("chromatic number search timed out after %v, the coloring may not be optimal!", timeout)
+	}
+
+	return best, count, nil
+}
+
+// Greedy coloring of indexed verteces in the given order
+func greedyColoring(verteces []VertexInterface, neighbours []map[int]bool, order []int) (map[string]int, int) {
+	colors := make([]int, len(verteces))
+	for i := range colors {
+		colors[i] = -1
+	}
+
+	count := 0
+	for _, v := range order {
+		used := make(map[int]bool)
+		for w := range neighbours[v] {
+			if colors[w] != -1 {
+				used[colors[w]] = true
+			}
+		}
+
+		c := 0
+		for used[c] {
+			c++
+		}
+		colors[v] = c
+		if c+1 > count {
+			count = c + 1
+		}
+	}
+
+	return colorMap(verteces, colors), count
+}
+
+// Index the verteces of a graph and build their neighbour sets for coloring
+// A vertex with a self-loop is its own neighbour, so no coloring exists
+func coloringNeighbours(g GraphInterface) ([]VertexInterface, []map[int]bool, error) {
+	verteces, index := indexVerteces(g)
+	neighbours := neighbourSets(verteces, index)
+	for i, v := range verteces {
+		if neighbours[i][i] {
+			return nil, nil, fmt.Errorf("vertex[name:%s] has a self-loop and can not be colored!", v.Name())
+		}
+	}
+	return verteces, neighbours, nil
+}
+
+// Build the neighbour set of each indexed vertex, edges are walked both ways
+func neighbourSets(verteces []VertexInterface, index map[string]int) []map[int]bool {
+	neighbours := make([]map[int]bool, len(verteces))
+	for i := range neighbours {
+		neighbours[i] = make(map[int]bool)
+	}
+
+	for i, v := range verteces {
+		for _, ei := range v.Edges() {
+			j := index[adjacentVertex(v, ei).Name()]
+			neighbours[i][j] = true
+			neighbours[j][i] = true
+		}
+	}
+
+	return neighbours
+}
+
+func colorMap(verteces []VertexInterface, colors []int) map[string]int {
+	m := make(map[string]int, len(verteces))
+	for i, v := range verteces {
+		m[v.Name()] = colors[i]
+	}
+	return m
+}
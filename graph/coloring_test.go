@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func Test4Coloring(t *testing.T) {
+	g := createNetworkGraph4Test(t)
+
+	// a self-loop can not be colored
+	loop := NewUndirectedGraph("LoopGraph")
+	loop.InsertVertex(NewVertex("a", 0))
+	loop.InsertVertex(NewVertex("b", 0))
+	loop.InsertEdgeByName("b", "b", NewEdge(1, UndirectedEdge))
+	loop.InsertEdgeByName("a", "b", NewEdge(1, UndirectedEdge))
+
+	for name, coloring := range map[string]func(GraphInterface) (map[string]int, int, error){
+		"greedy":       GreedyColoring,
+		"welsh-powell": WelshPowellColoring,
+		"dsatur":       DSaturColoring,
+	} {
+		colors, count, err := coloring(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkColoring(t, g, colors, count)
+		// triangles need 3 colors, and these heuristics find them
+		if count != 3 {
+			t.Errorf("%s uses %d colors, expected 3", name, count)
+		}
+
+		if _, _, err := coloring(loop); err == nil {
+			t.Errorf("%s colors a self-loop", name)
+		}
+	}
+	if _, _, err := ChromaticNumber(loop, 0); err == nil {
+		t.Error("chromatic number of a self-loop")
+	}
+}
+
+func Test4ChromaticNumber(t *testing.T) {
+	// the crown graph fools greedy coloring in name order: u_i and v_j are linked if i != j
+	g := NewUndirectedGraph("CrownGraph")
+	for i := 0; i < 4; i++ {
+		g.InsertVertex(NewVertex(fmt.Sprintf("%d_u", i), 0))
+		g.InsertVertex(NewVertex(fmt.Sprintf("%d_v", i), 0))
+	}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if i != j {
+				g.InsertEdgeByName(fmt.Sprintf("%d_u", i), fmt.Sprintf("%d_v", j), NewEdge(1, UndirectedEdge))
+			}
+		}
+	}
+
+	_, greedyCount, _ := GreedyColoring(g)
+	colors, count, err := ChromaticNumber(g, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkColoring(t, g, colors, count)
+	t.Logf("greedy:%d, exact:%d", greedyCount, count)
+	if greedyCount != 4 || count != 2 {
+		t.Errorf("greedy uses %d colors and exact uses %d, expected 4 and 2", greedyCount, count)
+	}
+
+	// the petersen graph has chromatic number 3
+	petersen := NewUndirectedGraph("PetersenGraph")
+	for i := 0; i < 10; i++ {
+		petersen.InsertVertex(NewVertex(fmt.Sprintf("p%d", i), i))
+	}
+	for i := 0; i < 5; i++ {
+		petersen.InsertEdgeByName(fmt.Sprintf("p%d", i), fmt.Sprintf("p%d", (i+1)%5), NewEdge(1, UndirectedEdge))
+		petersen.InsertEdgeByName(fmt.Sprintf("p%d", i), fmt.Sprintf("p%d", i+5), NewEdge(1, UndirectedEdge))
+		petersen.InsertEdgeByName(fmt.Sprintf("p%d", i+5), fmt.Sprintf("p%d", (i+2)%5+5), NewEdge(1, UndirectedEdge))
+	}
+	colors, count, err = ChromaticNumber(petersen, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkColoring(t, petersen, colors, count)
+	if count != 3 {
+		t.Errorf("petersen graph uses %d colors, expected 3", count)
+	}
+}
+
+// check that linked verteces have different colors within the color count
+func checkColoring(t *testing.T, g GraphInterface, colors map[string]int, count int) {
+	for _, v := range g.Verteces() {
+		c, ok := colors[v.Name()]
+		if !ok || c < 0 || c >= count {
+			t.Errorf("vertex %s has invalid color %d", v.Name(), c)
+		}
+		for _, ei := range v.Edges() {
+			if adj := adjacentVertex(v, ei); colors[adj.Name()] == c {
+				t.Errorf("%s and %s have the same color", v.Name(), adj.Name())
+			}
+		}
+	}
+}
@@ -0,0 +1,324 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+/**********************************************************************************/
+// community detection
+/**********************************************************************************/
+
+const communityEpsilon = 1e-12
+
+// Modularity of a partition of an undirected graph, weighted by edge weights
+// communities maps every vertex name to its community
+func Modularity(g GraphInterface, communities map[string]int) (float64, error) {
+	if err := checkCommunityGraph(g, "modularity"); err != nil {
+		return 0, err
+	}
+
+	verteces, index := indexVerteces(g)
+	membership := make([]int, len(verteces))
+	for i, v := range verteces {
+		c, ok := communities[v.Name()]
+		if !ok {
+			return 0, fmt.Errorf("vertex[name:%s] has no community!", v.Name())
+		}
+		membership[i] = c
+	}
+
+	return newCommunityGraph(verteces, index).modularity(membership), nil
+}
+
+// Louvain modularity optimization
+// Verteces are moved to the neighbour community with the best modularity gain until no move helps,
+// then every community is merged into a single vertex and the same is done on the merged graph
+// Communities are numbered from 0 in the order of vertex names, the modularity of them is returned as well
+func Louvain(g GraphInterface) (map[string]int, float64, error) {
+	if err := checkCommunityGraph(g, "louvain"); err != nil {
+		return nil, 0, err
+	}
+
+	verteces, index := indexVerteces(g)
+	base := newCommunityGraph(verteces, index)
+
+	// community of every vertex, as the node of the current level graph
+	membership := make([]int, len(verteces))
+	for i := range membership {
+		membership[i] = i
+	}
+
+	for cg := base; ; {
+		nodes, moved := cg.moveNodes()
+		if !moved {
+			break
+		}
+
+		nodes, k := renumber(nodes)
+		for i, c := range membership {
+			membership[i] = nodes[c]
+		}
+		cg = cg.aggregate(nodes, k)
+	}
+
+	membership, _ = renumber(membership)
+	return communityMap(verteces, membership), base.modularity(membership), nil
+}
+
+// Asynchronous label propagation
+// Every vertex starts in its own community, then verteces in random order take the label
+// with the largest edge weight among their neighbours, until every vertex holds such a label
+// Ties are broken at random by rnd, a nil rnd uses a fixed seed so the result is reproducible
+// If it does not settle in maxIterations (100 if not positive) rounds, the current labels are returned with an error
+func LabelPropagation(g GraphInterface, maxIterations int, rnd *rand.Rand) (map[string]int, float64, error) {
+	if err := checkCommunityGraph(g, "label propagation"); err != nil {
+		return nil, 0, err
+	}
+
+	if maxIterations <= 0 {
+		maxIterations = 100
+	}
+	if rnd == nil {
+		rnd = rand.New(rand.NewSource(1))
+	}
+
+	verteces, index := indexVerteces(g)
+	cg := newCommunityGraph(verteces, index)
+
+	n := len(verteces)
+	labels := make([]int, n)
+	order := make([]int, n)
+	for i := range labels {
+		labels[i] = i
+		order[i] = i
+	}
+
+	converged := false
+	for iter := 0; iter < maxIterations && !converged; iter++ {
+		rnd.Shuffle(n, func(i, j int) { order[i], order[j] = order[j], order[i] })
+
+		converged = true
+		for _, v := range order {
+			candidates := cg.heaviestLabels(v, labels)
+			if len(candidates) == 0 || indexOfInt(candidates, labels[v]) >= 0 {
+				continue
+			}
+			labels[v] = candidates[rnd.Intn(len(candidates))]
+			converged = false
+		}
+	}
+
+	membership, _ := renumber(labels)
+	communities := communityMap(verteces, membership)
+	modularity := cg.modularity(membership)
+	if !converged {
+		return communities, modularity, fmt.Errorf("label propagation does not converge in %d iterations!", maxIterations)
+	}
+
+	return communities, modularity, nil
+}
+
+// Check that the graph is undirected with non-negative weights
+func checkCommunityGraph(g GraphInterface, algorithm string) error {
+	if err := checkUndirectedEdges(g, algorithm); err != nil {
+		return err
+	}
+	return checkNonNegativeWeights(g, algorithm)
+}
+
+// Renumber communities from 0 in the order they first appear, and count them
+func renumber(membership []int) ([]int, int) {
+	ids := make(map[int]int)
+	renumbered := make([]int, len(membership))
+	for i, c := range membership {
+		id, ok := ids[c]
+		if !ok {
+			id = len(ids)
+			ids[c] = id
+		}
+		renumbered[i] = id
+	}
+	return renumbered, len(ids)
+}
+
+func communityMap(verteces []VertexInterface, membership []int) map[string]int {
+	communities := make(map[string]int, len(verteces))
+	for i, v := range verteces {
+		communities[v.Name()] = membership[i]
+	}
+	return communities
+}
+
+/**********************************************************************************/
+// weighted graph of communities
+/**********************************************************************************/
+
+// symmetric weighted graph over indexes, an edge inside a merged community becomes a self loop
+// total is the sum of all degrees, twice the total edge weight
+type communityGraph struct {
+	adj    [][]weightedArc
+	degree []float64
+	total  float64
+}
+
+func newCommunityGraph(verteces []VertexInterface, index map[string]int) *communityGraph {
+	weights := make([]map[int]float64, len(verteces))
+	for i, v := range verteces {
+		weights[i] = make(map[int]float64)
+		for _, ei := range v.EdgesBackward() {
+			weights[i][index[adjacentVertex(v, ei).Name()]] += float64(ei.Weight())
+		}
+	}
+
+	return newCommunityGraphFromWeights(weights)
+}
+
+func newCommunityGraphFromWeights(weights []map[int]float64) *communityGraph {
+	cg := &communityGraph{
+		adj:    make([][]weightedArc, len(weights)),
+		degree: make([]float64, len(weights)),
+	}
+
+	for i, row := range weights {
+		for j, w := range row {
+			cg.adj[i] = append(cg.adj[i], weightedArc{to: j, weight: w})
+		}
+		// stable neighbour order
+		sort.Slice(cg.adj[i], func(a, b int) bool { return cg.adj[i][a].to < cg.adj[i][b].to })
+		for _, arc := range cg.adj[i] {
+			cg.degree[i] += arc.weight
+		}
+		cg.total += cg.degree[i]
+	}
+
+	return cg
+}
+
+// Modularity of the nodes partitioned by membership
+func (cg *communityGraph) modularity(membership []int) float64 {
+	if cg.total == 0 {
+		return 0
+	}
+
+	inside := make(map[int]float64)
+	degrees := make(map[int]float64)
+	for i, arcs := range cg.adj {
+		degrees[membership[i]] += cg.degree[i]
+		for _, arc := range arcs {
+			if membership[i] == membership[arc.to] {
+				inside[membership[i]] += arc.weight
+			}
+		}
+	}
+
+	q := 0.0
+	for c, d := range degrees {
+		q += inside[c]/cg.total - (d/cg.total)*(d/cg.total)
+	}
+	return q
+}
+
+// Local moving phase of louvain, get the community of every node and whether any node has moved
+func (cg *communityGraph) moveNodes() ([]int, bool) {
+	n := len(cg.adj)
+	community := make([]int, n)
+	// total degree of every community
+	degrees := make([]float64, n)
+	for i := range community {
+		community[i] = i
+		degrees[i] = cg.degree[i]
+	}
+
+	if cg.total == 0 {
+		return community, false
+	}
+
+	moved := false
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < n; i++ {
+			current := community[i]
+			degrees[current] -= cg.degree[i]
+
+			// edge weight from node i into each neighbour community
+			links, neighbours := cg.communityLinks(i, community)
+
+			// gain of joining a community, up to a constant factor
+			gain := func(c int) float64 {
+				return links[c] - degrees[c]*cg.degree[i]/cg.total
+			}
+
+			best, bestGain := current, gain(current)
+			for _, c := range neighbours {
+				if g := gain(c); g > bestGain+communityEpsilon {
+					best, bestGain = c, g
+				}
+			}
+
+			degrees[best] += cg.degree[i]
+			if best != current {
+				community[i] = best
+				improved = true
+				moved = true
+			}
+		}
+	}
+
+	return community, moved
+}
+
+// Edge weight from node i into every community of its neighbours, self loops excluded
+// The neighbour communities are listed in the order they are found
+func (cg *communityGraph) communityLinks(i int, community []int) (map[int]float64, []int) {
+	links := make(map[int]float64)
+	var neighbours []int
+	for _, arc := range cg.adj[i] {
+		if arc.to == i {
+			continue
+		}
+		c := community[arc.to]
+		if _, ok := links[c]; !ok {
+			neighbours = append(neighbours, c)
+		}
+		links[c] += arc.weight
+	}
+	return links, neighbours
+}
+
+// Labels with the largest edge weight among the neighbours of node i
+func (cg *communityGraph) heaviestLabels(i int, labels []int) []int {
+	links, neighbours := cg.communityLinks(i, labels)
+
+	max := 0.0
+	for _, l := range neighbours {
+		if links[l] > max {
+			max = links[l]
+		}
+	}
+
+	var heaviest []int
+	for _, l := range neighbours {
+		if links[l] >= max-communityEpsilon {
+			heaviest = append(heaviest, l)
+		}
+	}
+	return heaviest
+}
+
+// Merge every community of k communities into a single node
+func (cg *communityGraph) aggregate(community []int, k int) *communityGraph {
+	weights := make([]map[int]float64, k)
+	for c := range weights {
+		weights[c] = make(map[int]float64)
+	}
+
+	for i, arcs := range cg.adj {
+		for _, arc := range arcs {
+			weights[community[i]][community[arc.to]] += arc.weight
+		}
+	}
+
+	return newCommunityGraphFromWeights(weights)
+}
@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func Test4Modularity(t *testing.T) {
+	g := createCommunityGraph4Test(t, 1)
+
+	// 2m = 14, each triangle holds 6 of the adjacency weights and 7 of the degrees
+	q, err := Modularity(g, map[string]int{"a": 0, "b": 0, "c": 0, "d": 1, "e": 1, "f": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(q-5.0/14) > 1e-9 {
+		t.Errorf("modularity is %v, expected %v", q, 5.0/14)
+	}
+
+	q, _ = Modularity(g, map[string]int{"a": 0, "b": 0, "c": 0, "d": 0, "e": 0, "f": 0})
+	if math.Abs(q) > 1e-9 {
+		t.Errorf("modularity of a single community is %v, expected 0", q)
+	}
+
+	if _, err := Modularity(g, map[string]int{"a": 0}); err == nil {
+		t.Error("verteces without community should be rejected")
+	}
+	if _, err := Modularity(createWeightedDirectedGraph4Test(t), nil); err == nil {
+		t.Error("directed graph should be rejected")
+	}
+}
+
+func Test4Louvain(t *testing.T) {
+	communities, q, err := Louvain(createNetworkGraph4Test(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkCommunities(t, communities, [][]string{{"a", "b", "c"}, {"d", "e", "f"}, {"g", "h"}, {"i"}})
+
+	// m = 8: two triangles (7 of the degrees each) and g-h (2 of the degrees)
+	expected := 2*(6.0/16-math.Pow(7.0/16, 2)) + 2.0/16 - math.Pow(2.0/16, 2)
+	if math.Abs(q-expected) > 1e-9 {
+		t.Errorf("modularity is %v, expected %v", q, expected)
+	}
+
+	// a heavy bridge pulls c and d together
+	communities, _, err = Louvain(createCommunityGraph4Test(t, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if communities["c"] != communities["d"] || communities["a"] == communities["c"] {
+		t.Errorf("unexpected communities %v", communities)
+	}
+}
+
+func Test4LabelPropagation(t *testing.T) {
+	g := createNetworkGraph4Test(t)
+	for seed := int64(0); seed < 20; seed++ {
+		communities, q, err := LabelPropagation(g, 0, rand.New(rand.NewSource(seed)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// labels never cross components
+		if communities["a"] == communities["g"] || communities["g"] != communities["h"] || communities["i"] == communities["h"] {
+			t.Errorf("unexpected communities %v", communities)
+		}
+
+		if expected, _ := Modularity(g, communities); math.Abs(q-expected) > 1e-9 {
+			t.Errorf("modularity is %v, expected %v", q, expected)
+		}
+	}
+
+	// tight triangles joined by a light bridge
+	communities, _, err := LabelPropagation(createCommunityGraph4Test(t, 0.1), 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkCommunities(t, communities, [][]string{{"a", "b", "c"}, {"d", "e", "f"}})
+}
+
+func checkCommunities(t *testing.T, communities map[string]int, expected [][]string) {
+	seen := make(map[int]bool)
+	for _, group := range expected {
+		c := communities[group[0]]
+		if seen[c] {
+			t.Errorf("community of %v is shared, %v", group, communities)
+		}
+		seen[c] = true
+		for _, name := range group {
+			if communities[name] != c {
+				t.Errorf("%s should be in the community of %s, %v", name, group[0], communities)
+			}
+		}
+	}
+}
+
+/// create two triangles a-b-c and d-e-f joined by the bridge c-d of the given weight
+func createCommunityGraph4Test(t *testing.T, bridge float32) *UndirectedGraph {
+	g := NewUndirectedGraph("CommunityGraph")
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		if g.InsertVertex(NewVertex(name, 0)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	if g.InsertEdgeByName("a", "b", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("b", "c", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("c", "a", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("c", "d", NewEdge(bridge, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("d", "e", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("e", "f", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("f", "d", NewEdge(1, UndirectedEdge)) != nil {
+		t.Error("InsertEdge error")
+	}
+
+	return g
+}
@@ -0,0 +1,155 @@
+package graph
+
+/**********************************************************************************/
+// undirected connectivity
+/**********************************************************************************/
+
+// Connected components of an undirected graph
+// Edges of a directed graph are walked both ways, which gives its weakly connected components
+// Components are ordered by their smallest vertex name, members by name
+func ConnectedComponents(g GraphInterface) [][]VertexInterface {
+	verteces, index := indexVerteces(g)
+	set := newDisjointSet(len(verteces))
+	for i, v := range verteces {
+		for _, ei := range v.Edges() {
+			set.union(i, index[adjacentVertex(v, ei).Name()])
+		}
+	}
+
+	var components [][]VertexInterface
+	componentOf := make(map[int]int)
+	for i, v := range verteces {
+		root := set.find(i)
+		c, ok := componentOf[root]
+		if !ok {
+			c = len(components)
+			componentOf[root] = c
+			components = append(components, nil)
+		}
+		components[c] = append(components[c], v)
+	}
+
+	return components
+}
+
+// Bridges of an undirected graph: edges whose removal disconnects their endpoints
+func Bridges(g GraphInterface) []EdgeInterface {
+	return newLowLink(g).bridges
+}
+
+// Articulation points of an undirected graph: verteces whose removal increases the number of components
+func ArticulationPoints(g GraphInterface) []VertexInterface {
+	return newLowLink(g).articulations
+}
+
+// Biconnected components of an undirected graph, each given as its edges
+// Two edges share a component if they lie on a common simple cycle, a bridge forms a component alone
+func BiconnectedComponents(g GraphInterface) [][]EdgeInterface {
+	return newLowLink(g).biconnected
+}
+
+/**********************************************************************************/
+// low-link dfs
+/**********************************************************************************/
+
+// one dfs computing bridges, articulation points and biconnected components
+type lowLink struct {
+	verteces []VertexInterface
+	index    map[string]int
+	order    []int
+	low      []int
+	counter  int
+	// edges of the biconnected component being built
+	edgeStack []EdgeInterface
+
+	bridges       []EdgeInterface
+	articulations []VertexInterface
+	biconnected   [][]EdgeInterface
+}
+
+func newLowLink(g GraphInterface) *lowLink {
+	verteces, index := indexVerteces(g)
+	l := &lowLink{
+		verteces: verteces,
+		index:    index,
+		order:    make([]int, len(verteces)),
+		low:      make([]int, len(verteces)),
+	}
+	for i := range l.order {
+		l.order[i] = -1
+	}
+
+	for i := range verteces {
+		if l.order[i] == -1 {
+			l.visit(i, -1)
+		}
+	}
+
+	return l
+}
+
+func (l *lowLink) visit(v, parent int) {
+	l.order[v] = l.counter
+	l.low[v] = l.counter
+	l.counter++
+
+	children := 0
+	isArticulation := false
+	skippedParent := false
+	for _, ei := range l.verteces[v].Edges() {
+		w := l.index[adjacentVertex(l.verteces[v], ei).Name()]
+		if w == v {
+			continue
+		}
+		// skip the tree edge back to parent once, a parallel edge is still a back edge
+		if w == parent && !skippedParent {
+			skippedParent = true
+			continue
+		}
+
+		if l.order[w] == -1 {
+			children++
+			l.edgeStack = append(l.edgeStack, ei)
+			l.visit(w, v)
+			if l.low[w] < l.low[v] {
+				l.low[v] = l.low[w]
+			}
+
+			if l.low[w] > l.order[v] {
+				l.bridges = append(l.bridges, ei)
+			}
+
+			// v separates the subtree of w, pop its component
+			if l.low[w] >= l.order[v] {
+				if parent != -1 {
+					isArticulation = true
+				}
+
+				var component []EdgeInterface
+				for {
+					top := l.edgeStack[len(l.edgeStack)-1]
+					l.edgeStack = l.edgeStack[:len(l.edgeStack)-1]
+					component = append(component, top)
+					if top == ei {
+						break
+					}
+				}
+				l.biconnected = append(l.biconnected, component)
+			}
+		} else if l.order[w] < l.order[v] {
+			// back edge to an ancestor
+			l.edgeStack = append(l.edgeStack, ei)
+			if l.order[w] < l.low[v] {
+				l.low[v] = l.order[w]
+			}
+		}
+	}
+
+	// the dfs root separates its children
+	if parent == -1 && children > 1 {
+		isArticulation = true
+	}
+	if isArticulation {
+		l.articulations = append(l.articulations, l.verteces[v])
+	}
+}
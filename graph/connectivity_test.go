@@ -0,0 +1,98 @@
+package graph
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+func Test4ConnectedComponents(t *testing.T) {
+	g := createNetworkGraph4Test(t)
+
+	components := ConnectedComponents(g)
+	if len(components) != 3 || len(components[0]) != 6 || len(components[1]) != 2 || len(components[2]) != 1 {
+		t.Errorf("unexpected components %v", components)
+	}
+
+	// directed graph gives weakly connected components
+	if len(ConnectedComponents(createDirectedGraph4Test(t))) != 1 {
+		t.Error("directed test graph is weakly connected")
+	}
+}
+
+func Test4BridgesAndArticulationPoints(t *testing.T) {
+	g := createNetworkGraph4Test(t)
+
+	var bridges []string
+	for _, ei := range Bridges(g) {
+		bridges = append(bridges, edgeName(ei))
+	}
+	sort.Strings(bridges)
+	if strings.Join(bridges, " ") != "c-d g-h" {
+		t.Errorf("bridges are %v, expected [c-d g-h]", bridges)
+	}
+
+	var points []string
+	for _, v := range ArticulationPoints(g) {
+		points = append(points, v.Name())
+	}
+	sort.Strings(points)
+	if strings.Join(points, " ") != "c d" {
+		t.Errorf("articulation points are %v, expected [c d]", points)
+	}
+}
+
+func Test4BiconnectedComponents(t *testing.T) {
+	g := createNetworkGraph4Test(t)
+
+	var components []string
+	for _, c := range BiconnectedComponents(g) {
+		var names []string
+		for _, ei := range c {
+			names = append(names, edgeName(ei))
+		}
+		sort.Strings(names)
+		components = append(components, strings.Join(names, ","))
+	}
+	sort.Strings(components)
+	t.Log(components)
+
+	expected := "a-b,a-c,b-c c-d d-e,d-f,e-f g-h"
+	if strings.Join(components, " ") != expected {
+		t.Errorf("biconnected components are %v, expected %s", components, expected)
+	}
+}
+
+// name an undirected edge by its sorted endpoints
+func edgeName(ei EdgeInterface) string {
+	names := []string{ei.From().Name(), ei.To().Name()}
+	sort.Strings(names)
+	return strings.Join(names, "-")
+}
+
+/// create undirected network graph for test
+//   a       e
+//   | \   / |
+//   b - c - d - f     g - h     i
+// with triangles a-b-c and d-e-f
+func createNetworkGraph4Test(t *testing.T) *UndirectedGraph {
+	g := NewUndirectedGraph("NetworkGraph")
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"} {
+		if g.InsertVertex(NewVertex(name, 0)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	if g.InsertEdgeByName("a", "b", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("b", "c", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("c", "a", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("c", "d", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("d", "e", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("e", "f", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("f", "d", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("g", "h", NewEdge(1, UndirectedEdge)) != nil {
+		t.Error("InsertEdge error")
+	}
+
+	return g
+}
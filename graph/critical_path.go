@@ -0,0 +1,150 @@
+package graph
+
+import (
+	"math"
+	"sort"
+)
+
+/**********************************************************************************/
+// longest path and critical path method
+/**********************************************************************************/
+
+const scheduleEpsilon = 1e-9
+
+// accessor reading a value from a vertex
+type VertexValueFunc func(VertexInterface) float64
+
+// Read the data of a vertex as a duration, 0 if it is not a number
+func DataDuration(v VertexInterface) float64 {
+	switch d := v.Data().(type) {
+	case int:
+		return float64(d)
+	case int32:
+		return float64(d)
+	case int64:
+		return float64(d)
+	case float32:
+		return float64(d)
+	case float64:
+		return d
+	}
+	return 0
+}
+
+// schedule of the tasks of a DAG by the critical path method
+type Schedule struct {
+	// time to finish every task
+	Duration float64
+	// by vertex name
+	EarliestStart map[string]float64
+	LatestStart   map[string]float64
+	// how long a task may be delayed without delaying the whole schedule
+	Slack map[string]float64
+	// a chain of tasks without slack from a start to the end of the schedule
+	CriticalPath Path
+}
+
+// Determine if a task has no slack
+func (s *Schedule) IsCritical(v VertexInterface) bool {
+	slack, ok := s.Slack[v.Name()]
+	return ok && slack <= scheduleEpsilon
+}
+
+// Longest path of a DAG by edge weights, built on topological sort
+// A *CycleError is returned if the graph has a cycle
+func LongestPath(g *DAG) (Path, error) {
+	s, err := CriticalPath(g, nil)
+	if err != nil {
+		return Path{}, err
+	}
+	return s.CriticalPath, nil
+}
+
+// Critical path method over a DAG of tasks
+// A task takes duration(v) (0 if duration is nil), and an edge u -> v lets v start weight time after u finishes,
+// so durations may be kept in verteces, in edges or in both. Tasks without predecessor start at 0
+// The critical path costs the whole duration, a *CycleError is returned if the graph has a cycle
+func CriticalPath(g *DAG, duration VertexValueFunc) (*Schedule, error) {
+	sorted, err := TopoSort(g)
+	if err != nil {
+		return nil, err
+	}
+
+	if duration == nil {
+		duration = func(VertexInterface) float64 { return 0 }
+	}
+
+	s := &Schedule{
+		EarliestStart: make(map[string]float64, len(sorted)),
+		LatestStart:   make(map[string]float64, len(sorted)),
+		Slack:         make(map[string]float64, len(sorted)),
+	}
+	durations := make(map[string]float64, len(sorted))
+	for _, v := range sorted {
+		durations[v.Name()] = duration(v)
+	}
+
+	// forward pass: earliest start after every predecessor
+	for _, v := range sorted {
+		es := 0.0
+		for i, ei := range v.EdgesForward() {
+			start := s.EarliestStart[ei.From().Name()] + durations[ei.From().Name()] + float64(ei.Weight())
+			if i == 0 || start > es {
+				es = start
+			}
+		}
+		s.EarliestStart[v.Name()] = es
+		s.Duration = math.Max(s.Duration, es+durations[v.Name()])
+	}
+
+	// backward pass: latest start before every successor
+	for i := len(sorted) - 1; i >= 0; i-- {
+		v := sorted[i]
+		lf := s.Duration
+		for _, ei := range v.EdgesBackward() {
+			lf = math.Min(lf, s.LatestStart[ei.To().Name()]-float64(ei.Weight()))
+		}
+		s.LatestStart[v.Name()] = lf - durations[v.Name()]
+		s.Slack[v.Name()] = s.LatestStart[v.Name()] - s.EarliestStart[v.Name()]
+	}
+
+	s.CriticalPath = criticalChain(s, sorted, durations)
+	return s, nil
+}
+
+// Walk back from a task finishing last through predecessors which make it start as early as it does
+// Ties are broken by vertex name
+func criticalChain(s *Schedule, sorted []VertexInterface, durations map[string]float64) Path {
+	verteces := append([]VertexInterface{}, sorted...)
+	sort.Slice(verteces, func(i, j int) bool { return verteces[i].Name() < verteces[j].Name() })
+
+	var last VertexInterface
+	for _, v := range verteces {
+		if math.Abs(s.EarliestStart[v.Name()]+durations[v.Name()]-s.Duration) <= scheduleEpsilon {
+			last = v
+			break
+		}
+	}
+	if last == nil {
+		return Path{}
+	}
+
+	chain := []VertexInterface{last}
+	for v := last; ; {
+		var prev VertexInterface
+		for _, ei := range v.EdgesForward() {
+			u := ei.From()
+			start := s.EarliestStart[u.Name()] + durations[u.Name()] + float64(ei.Weight())
+			if math.Abs(start-s.EarliestStart[v.Name()]) <= scheduleEpsilon && (prev == nil || u.Name() < prev.Name()) {
+				prev = u
+			}
+		}
+		if prev == nil {
+			break
+		}
+		chain = append(chain, prev)
+		v = prev
+	}
+
+	return Path{Verteces: reverseVerteces(chain), Cost: s.Duration}
+}
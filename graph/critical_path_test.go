@@ -0,0 +1,77 @@
+package graph
+
+import (
+	"testing"
+)
+
+func Test4LongestPath(t *testing.T) {
+	path, err := LongestPath(createDependencyGraph4Test(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPath(t, path.Verteces, "a", "d", "e")
+	if path.Cost != 6 {
+		t.Errorf("longest path costs %v, expected 6", path.Cost)
+	}
+
+	cyclic := createDependencyGraph4Test(t)
+	cyclic.InsertEdgeByName("e", "b", NewEdge(1, BackwardEdge))
+	if _, err := LongestPath(cyclic); err == nil {
+		t.Error("cyclic graph should be rejected")
+	}
+}
+
+func Test4CriticalPath(t *testing.T) {
+	g := createTaskGraph4Test(t, 0)
+
+	s, err := CriticalPath(g, DataDuration)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Duration != 10 {
+		t.Errorf("schedule takes %v, expected 10", s.Duration)
+	}
+
+	expected := map[string][3]float64{
+		// earliest start, latest start, slack
+		"A": {0, 0, 0}, "B": {0, 1, 1}, "C": {3, 3, 0}, "D": {2, 5, 3}, "E": {7, 7, 0},
+	}
+	for name, e := range expected {
+		if s.EarliestStart[name] != e[0] || s.LatestStart[name] != e[1] || s.Slack[name] != e[2] {
+			t.Errorf("task %s starts in [%v, %v] with slack %v, expected %v",
+				name, s.EarliestStart[name], s.LatestStart[name], s.Slack[name], e)
+		}
+	}
+	checkPath(t, s.CriticalPath.Verteces, "A", "C", "E")
+	if !s.IsCritical(g.GetVertex("C")) || s.IsCritical(g.GetVertex("D")) {
+		t.Error("C should be critical while D should not")
+	}
+
+	// a lag of 4 after B makes B and D critical
+	s, _ = CriticalPath(createTaskGraph4Test(t, 4), DataDuration)
+	if s.Duration != 11 || s.Slack["A"] != 1 {
+		t.Errorf("schedule takes %v with slack of A %v, expected 11 and 1", s.Duration, s.Slack["A"])
+	}
+	checkPath(t, s.CriticalPath.Verteces, "B", "D", "E")
+}
+
+/// create task graph for test, durations are kept in vertex data
+// A:3, B:2, C:4, D:2, E:3 with A->C, B->C, B->D, C->E, D->E, and the given lag between B and D
+func createTaskGraph4Test(t *testing.T, lag float32) *DAG {
+	g := NewDAG("TaskGraph")
+	for name, duration := range map[string]int{"A": 3, "B": 2, "C": 4, "D": 2, "E": 3} {
+		if g.InsertVertex(NewVertex(name, duration)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	if g.InsertEdgeByName("A", "C", NewEdge(0, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("B", "C", NewEdge(0, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("B", "D", NewEdge(lag, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("C", "E", NewEdge(0, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("D", "E", NewEdge(0, BackwardEdge)) != nil {
+		t.Error("InsertEdge error")
+	}
+
+	return g
+}
@@ -52,14 +52,6 @@ func NewDAG(name string) *DAG {
 }
 
 func (g *DAG) IsDag() bool {
-	sortVertexList, err := TopoSort(g)
-	if err != nil {
-		return false
-	}
-
-	if len(sortVertexList) < len(g.Verteces()) {
-		return false
-	}
-
-	return true
+	_, err := TopoSort(g)
+	return err == nil
 }
\ No newline at end of file
@@ -0,0 +1,237 @@
+package graph
+
+import (
+	"sort"
+)
+
+/**********************************************************************************/
+// dominators
+/**********************************************************************************/
+
+// dominator tree of the verteces reachable from a root
+// A vertex d dominates v when every path from the root to v goes through d,
+// for post-dominators every path from v to the root (the exit) goes through d
+type DominatorTree struct {
+	Root VertexInterface
+	// immediate dominator by vertex name, the root and verteces unreachable from it have none
+	Idom map[string]VertexInterface
+	// verteces immediately dominated by a vertex, by vertex name and ordered by name
+	Children map[string][]VertexInterface
+	// dominance frontier by vertex name: verteces where the dominance of the vertex ends, ordered by name
+	Frontier map[string][]VertexInterface
+
+	// preorder and postorder numbers in the tree
+	pre, post map[string]int
+}
+
+// Lengauer-Tarjan dominators of the verteces reachable from entry, following edges by EdgesBackward
+func Dominators(g GraphInterface, entry VertexInterface) (*DominatorTree, error) {
+	if err := checkVertex(g, entry); err != nil {
+		return nil, err
+	}
+	return lengauerTarjan(g, entry, VertexInterface.EdgesBackward), nil
+}
+
+// Lengauer-Tarjan post-dominators of the verteces reaching exit, following edges backward by EdgesForward
+// The frontiers of post-dominators give the control dependences of a control-flow graph
+func PostDominators(g GraphInterface, exit VertexInterface) (*DominatorTree, error) {
+	if err := checkVertex(g, exit); err != nil {
+		return nil, err
+	}
+	return lengauerTarjan(g, exit, VertexInterface.EdgesForward), nil
+}
+
+// Determine if a dominates b, every vertex of the tree dominates itself
+func (dt *DominatorTree) Dominates(a, b VertexInterface) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	preA, ok := dt.pre[a.Name()]
+	if !ok {
+		return false
+	}
+	preB, ok := dt.pre[b.Name()]
+	if !ok {
+		return false
+	}
+
+	return preA <= preB && dt.post[b.Name()] <= dt.post[a.Name()]
+}
+
+func lengauerTarjan(g GraphInterface, root VertexInterface, edges func(VertexInterface) []EdgeInterface) *DominatorTree {
+	verteces, index := indexVerteces(g)
+	n := len(verteces)
+
+	succ := make([][]int, n)
+	for i, v := range verteces {
+		for _, ei := range edges(v) {
+			succ[i] = append(succ[i], index[adjacentVertex(v, ei).Name()])
+		}
+		sort.Ints(succ[i])
+	}
+
+	// depth-first numbering from the root, the arrays below are indexed by these numbers
+	dfn := make([]int, n)
+	for i := range dfn {
+		dfn[i] = -1
+	}
+	var order, parent []int
+	type frame struct{ v, next, parent int }
+	stack := []frame{{index[root.Name()], 0, -1}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.next == 0 && dfn[top.v] < 0 {
+			dfn[top.v] = len(order)
+			order = append(order, top.v)
+			parent = append(parent, top.parent)
+		}
+		if top.next == len(succ[top.v]) {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		w := succ[top.v][top.next]
+		top.next++
+		if dfn[w] < 0 {
+			stack = append(stack, frame{w, 0, dfn[top.v]})
+		}
+	}
+
+	reached := len(order)
+	pred := make([][]int, reached)
+	for i, v := range order {
+		for _, w := range succ[v] {
+			pred[dfn[w]] = append(pred[dfn[w]], i)
+		}
+	}
+
+	semi := make([]int, reached)
+	idom := make([]int, reached)
+	ancestor := make([]int, reached)
+	best := make([]int, reached)
+	bucket := make([][]int, reached)
+	for i := range semi {
+		semi[i] = i
+		ancestor[i] = -1
+		best[i] = i
+	}
+
+	// path compression on the forest of processed verteces
+	compress := func(v int) {
+		var chain []int
+		for u := v; ancestor[ancestor[u]] >= 0; u = ancestor[u] {
+			chain = append(chain, u)
+		}
+		for i := len(chain) - 1; i >= 0; i-- {
+			u := chain[i]
+			a := ancestor[u]
+			if semi[best[a]] < semi[best[u]] {
+				best[u] = best[a]
+			}
+			ancestor[u] = ancestor[a]
+		}
+	}
+	eval := func(v int) int {
+		if ancestor[v] < 0 {
+			return v
+		}
+		compress(v)
+		return best[v]
+	}
+
+	for w := reached - 1; w > 0; w-- {
+		for _, v := range pred[w] {
+			if u := eval(v); semi[u] < semi[w] {
+				semi[w] = semi[u]
+			}
+		}
+		bucket[semi[w]] = append(bucket[semi[w]], w)
+		ancestor[w] = parent[w]
+
+		p := parent[w]
+		for _, v := range bucket[p] {
+			if u := eval(v); semi[u] < semi[v] {
+				idom[v] = u
+			} else {
+				idom[v] = p
+			}
+		}
+		bucket[p] = nil
+	}
+	for w := 1; w < reached; w++ {
+		if idom[w] != semi[w] {
+			idom[w] = idom[idom[w]]
+		}
+	}
+	if reached > 0 {
+		idom[0] = -1
+	}
+
+	return newDominatorTree(root, order, idom, pred, verteces)
+}
+
+// Build the tree and the dominance frontiers from immediate dominators, all indexed by dfs numbers
+func newDominatorTree(root VertexInterface, order, idom []int, pred [][]int, verteces []VertexInterface) *DominatorTree {
+	dt := &DominatorTree{
+		Root:     root,
+		Idom:     make(map[string]VertexInterface),
+		Children: make(map[string][]VertexInterface),
+		Frontier: make(map[string][]VertexInterface),
+		pre:      make(map[string]int),
+		post:     make(map[string]int),
+	}
+	vertex := func(i int) VertexInterface { return verteces[order[i]] }
+
+	children := make([][]int, len(order))
+	for w := 1; w < len(order); w++ {
+		dt.Idom[vertex(w).Name()] = vertex(idom[w])
+		children[idom[w]] = append(children[idom[w]], w)
+	}
+	for v, list := range children {
+		sort.Slice(list, func(i, j int) bool { return order[list[i]] < order[list[j]] })
+		for _, w := range list {
+			dt.Children[vertex(v).Name()] = append(dt.Children[vertex(v).Name()], vertex(w))
+		}
+	}
+
+	// a join point is in the frontier of every vertex from its predecessors up to its immediate dominator
+	frontier := make([]map[int]bool, len(order))
+	for b := range order {
+		for _, p := range pred[b] {
+			for runner := p; runner >= 0 && runner != idom[b]; runner = idom[runner] {
+				if frontier[runner] == nil {
+					frontier[runner] = make(map[int]bool)
+				}
+				frontier[runner][b] = true
+			}
+		}
+	}
+	for v, set := range frontier {
+		var list []VertexInterface
+		for b := range set {
+			list = append(list, vertex(b))
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].Name() < list[j].Name() })
+		if len(list) > 0 {
+			dt.Frontier[vertex(v).Name()] = list
+		}
+	}
+
+	// number the tree for dominance queries
+	counter := 0
+	var number func(v int)
+	number = func(v int) {
+		dt.pre[vertex(v).Name()] = counter
+		counter++
+		for _, w := range children[v] {
+			number(w)
+		}
+		dt.post[vertex(v).Name()] = counter
+		counter++
+	}
+	if len(order) > 0 {
+		number(0)
+	}
+
+	return dt
+}
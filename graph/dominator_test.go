@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func Test4Dominators(t *testing.T) {
+	// the example of Lengauer and Tarjan
+	g := createLinkGraph4Test(t, splitLinks("R->A R->B R->C A->D B->A B->D B->E C->F C->G D->L E->H F->I G->I G->J H->E H->K I->K J->I K->I K->R L->H"))
+
+	dt, err := Dominators(g, g.GetVertex("R"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkIdom(t, dt, "A:R B:R C:R D:R E:R F:C G:C H:R I:R J:G K:R L:D")
+	checkPath(t, dt.Children["C"], "F", "G")
+	if !dt.Dominates(g.GetVertex("C"), g.GetVertex("J")) || dt.Dominates(g.GetVertex("B"), g.GetVertex("E")) {
+		t.Error("C should dominate J while B should not dominate E")
+	}
+
+	if _, err := Dominators(g, NewVertex("X", 0)); err == nil {
+		t.Error("entry out of graph should be rejected")
+	}
+}
+
+func Test4DominanceFrontier(t *testing.T) {
+	// a loop around an if-then-else
+	g := createLinkGraph4Test(t, splitLinks("entry->cond cond->then cond->else then->join else->join join->cond join->exit"))
+
+	dt, _ := Dominators(g, g.GetVertex("entry"))
+	checkIdom(t, dt, "cond:entry else:cond exit:join join:cond then:cond")
+	checkFrontier(t, dt, "cond:cond else:join join:cond then:join")
+
+	pdt, _ := PostDominators(g, g.GetVertex("exit"))
+	checkIdom(t, pdt, "cond:join else:join entry:cond join:exit then:join")
+	checkFrontier(t, pdt, "cond:join else:cond join:join then:cond")
+
+	// dominators of unreachable verteces are unknown
+	g.InsertVertex(NewVertex("dead", 0))
+	g.InsertEdgeByName("dead", "join", NewEdge(1, BackwardEdge))
+	dt, _ = Dominators(g, g.GetVertex("entry"))
+	if dt.Idom["dead"] != nil || dt.Idom["join"].Name() != "cond" || dt.Dominates(g.GetVertex("entry"), g.GetVertex("dead")) {
+		t.Error("unreachable vertex should be left out")
+	}
+}
+
+func Test4Dominators_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	for round := 0; round < 30; round++ {
+		g := NewDirectedGraph("RandomGraph")
+		n := 20
+		for i := 0; i < n; i++ {
+			g.InsertVertex(NewVertex(fmt.Sprintf("v%02d", i), 0))
+		}
+		for i := 0; i < 2*n; i++ {
+			g.InsertEdgeByName(fmt.Sprintf("v%02d", rnd.Intn(n)), fmt.Sprintf("v%02d", rnd.Intn(n)), NewEdge(1, BackwardEdge))
+		}
+
+		root := g.GetVertex("v00")
+		dt, _ := Dominators(g, root)
+		reachable := reachableWithout(root, nil)
+		for _, d := range g.Verteces() {
+			// d dominates v if v is not reachable any more without d
+			without := reachableWithout(root, d)
+			for _, v := range g.Verteces() {
+				expected := reachable[v.Name()] && reachable[d.Name()] && (d == v || !without[v.Name()])
+				if dt.Dominates(d, v) != expected {
+					t.Fatalf("Dominates(%s, %s) should be %v", d.Name(), v.Name(), expected)
+				}
+			}
+		}
+	}
+}
+
+// Get verteces reachable from root, without walking through the removed vertex
+func reachableWithout(root, removed VertexInterface) map[string]bool {
+	visited := make(map[string]bool)
+	if removed != nil {
+		if removed == root {
+			return visited
+		}
+		visited[removed.Name()] = true
+	}
+	BFSVertex(root, visited, func(VertexInterface) {})
+	if removed != nil {
+		delete(visited, removed.Name())
+	}
+	return visited
+}
+
+func checkIdom(t *testing.T, dt *DominatorTree, expected string) {
+	var items []string
+	verteces := make([]string, 0, len(dt.Idom))
+	for name := range dt.Idom {
+		verteces = append(verteces, name)
+	}
+	sort.Strings(verteces)
+	for _, name := range verteces {
+		items = append(items, name+":"+dt.Idom[name].Name())
+	}
+	if got := strings.Join(items, " "); got != expected {
+		t.Errorf("immediate dominators are %s, expected %s", got, expected)
+	}
+}
+
+func checkFrontier(t *testing.T, dt *DominatorTree, expected string) {
+	var items []string
+	verteces := make([]string, 0, len(dt.Frontier))
+	for name := range dt.Frontier {
+		verteces = append(verteces, name)
+	}
+	sort.Strings(verteces)
+	for _, name := range verteces {
+		for _, v := range dt.Frontier[name] {
+			items = append(items, name+":"+v.Name())
+		}
+	}
+	if got := strings.Join(items, " "); got != expected {
+		t.Errorf("dominance frontiers are %s, expected %s", got, expected)
+	}
+}
+
+// Split links given as 'from->to' items
+func splitLinks(links string) [][2]string {
+	var pairs [][2]string
+	for _, link := range strings.Fields(links) {
+		names := strings.Split(link, "->")
+		pairs = append(pairs, [2]string{names[0], names[1]})
+	}
+	return pairs
+}
@@ -0,0 +1,125 @@
+package graph
+
+/**********************************************************************************/
+// elementary cycles
+/**********************************************************************************/
+
+// Enumerate elementary cycles by Johnson's algorithm, streaming each cycle to fn
+// A cycle is given in walking order starting from its smallest vertex name,
+// and the last vertex goes back to the first one
+// maxCycles limits the number of cycles and maxLength the number of verteces of a cycle, 0 means no limit
+// fn returns false to stop the enumeration
+// Undirected edges are walked both ways, so each of them is a cycle of two verteces
+// Return the number of cycles passed to fn
+func ElementaryCycles(g GraphInterface, maxCycles, maxLength int, fn func(cycle []VertexInterface) bool) int {
+	verteces, index := indexVerteces(g)
+	n := len(verteces)
+
+	// successor lists without duplicates
+	adj := make([][]int, n)
+	for i, succ := range adjacencyList(verteces, index) {
+		seen := make(map[int]bool)
+		for _, w := range succ {
+			if !seen[w] {
+				seen[w] = true
+				adj[i] = append(adj[i], w)
+			}
+		}
+	}
+
+	blocked := make([]bool, n)
+	blockedBy := make([]map[int]bool, n)
+	var stack []int
+	count := 0
+	stopped := false
+
+	var unblock func(v int)
+	unblock = func(v int) {
+		blocked[v] = false
+		for w := range blockedBy[v] {
+			delete(blockedBy[v], w)
+			if blocked[w] {
+				unblock(w)
+			}
+		}
+	}
+
+	// search cycles through start inside its component
+	var circuit func(v, start int, inComponent []bool) bool
+	circuit = func(v, start int, inComponent []bool) bool {
+		found := false
+		stack = append(stack, v)
+		blocked[v] = true
+
+		for _, w := range adj[v] {
+			if stopped {
+				break
+			}
+			if !inComponent[w] {
+				continue
+			}
+
+			if w == start {
+				cycle := make([]VertexInterface, 0, len(stack))
+				for _, i := range stack {
+					cycle = append(cycle, verteces[i])
+				}
+				count++
+				if !fn(cycle) || (maxCycles > 0 && count >= maxCycles) {
+					stopped = true
+				}
+				found = true
+			} else if maxLength > 0 && len(stack) >= maxLength {
+				// too long to go deeper, keep v unblocked as w may still close a shorter cycle later
+				found = true
+			} else if !blocked[w] {
+				if circuit(w, start, inComponent) {
+					found = true
+				}
+			}
+		}
+
+		if found {
+			unblock(v)
+		} else {
+			for _, w := range adj[v] {
+				if inComponent[w] {
+					blockedBy[w][v] = true
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		return found
+	}
+
+	for start := 0; start < n && !stopped; start++ {
+		// strongly connected component of start in the subgraph induced by start..n-1
+		sub := make([][]int, n-start)
+		for v := start; v < n; v++ {
+			for _, w := range adj[v] {
+				if w >= start {
+					sub[v-start] = append(sub[v-start], w-start)
+				}
+			}
+		}
+
+		inComponent := make([]bool, n)
+		for _, component := range tarjan(sub) {
+			if component[0] == 0 {
+				for _, i := range component {
+					inComponent[i+start] = true
+				}
+				break
+			}
+		}
+
+		for i := start; i < n; i++ {
+			blocked[i] = false
+			blockedBy[i] = make(map[int]bool)
+		}
+		circuit(start, start, inComponent)
+	}
+
+	return count
+}
@@ -0,0 +1,58 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func Test4ElementaryCycles(t *testing.T) {
+	g := createCyclicGraph4Test(t)
+
+	var cycles []string
+	count := ElementaryCycles(g, 0, 0, func(cycle []VertexInterface) bool {
+		checkCycle(t, cycle)
+		cycles = append(cycles, cycleString(cycle))
+		return true
+	})
+	t.Log(cycles)
+
+	if count != 2 || strings.Join(cycles, ", ") != "a -> b -> c -> a, d -> e -> d" {
+		t.Errorf("cycles are %v", cycles)
+	}
+}
+
+func Test4ElementaryCycles_Limit(t *testing.T) {
+	// complete directed graph of 4 verteces has 6 + 8 + 6 elementary cycles
+	g := NewDirectedGraph("CompleteGraph")
+	for i := 0; i < 4; i++ {
+		g.InsertVertex(NewVertex(fmt.Sprintf("v%d", i), i))
+	}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if i != j {
+				g.InsertEdgeByName(fmt.Sprintf("v%d", i), fmt.Sprintf("v%d", j), NewEdge(1, BackwardEdge))
+			}
+		}
+	}
+
+	all := func(cycle []VertexInterface) bool { return true }
+	if count := ElementaryCycles(g, 0, 0, all); count != 20 {
+		t.Errorf("found %d cycles, expected 20", count)
+	}
+	if count := ElementaryCycles(g, 0, 3, all); count != 14 {
+		t.Errorf("found %d cycles no longer than 3, expected 14", count)
+	}
+	if count := ElementaryCycles(g, 5, 0, all); count != 5 {
+		t.Errorf("found %d cycles, expected the limit 5", count)
+	}
+
+	seen := 0
+	ElementaryCycles(g, 0, 0, func(cycle []VertexInterface) bool {
+		seen++
+		return seen < 3
+	})
+	if seen != 3 {
+		t.Errorf("callback should stop the enumeration, called %d times", seen)
+	}
+}
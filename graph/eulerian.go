@@ -0,0 +1,228 @@
+package graph
+
+import (
+	"fmt"
+)
+
+/**********************************************************************************/
+// eulerian path and circuit
+/**********************************************************************************/
+
+// Determine if a graph has a path walking every edge exactly once
+func HasEulerianPath(g GraphInterface) bool {
+	_, err := eulerianStart(g, false)
+	return err == nil
+}
+
+// Determine if a graph has a circuit walking every edge exactly once
+func HasEulerianCircuit(g GraphInterface) bool {
+	_, err := eulerianStart(g, true)
+	return err == nil
+}
+
+// Hierholzer eulerian path, a circuit is given if the graph has one
+// Return the edges in walking order and the verteces walked through, which are one more than the edges
+// An undirected edge may be walked from its 'to' vertex, follow the verteces for the direction
+func EulerianPath(g GraphInterface) ([]EdgeInterface, []VertexInterface, error) {
+	start, err := eulerianStart(g, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	edges, verteces := hierholzer(g, start)
+	return edges, verteces, nil
+}
+
+// Hierholzer eulerian circuit, the last vertex walked through is the first one
+func EulerianCircuit(g GraphInterface) ([]EdgeInterface, []VertexInterface, error) {
+	start, err := eulerianStart(g, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	edges, verteces := hierholzer(g, start)
+	return edges, verteces, nil
+}
+
+// Find where an eulerian path (or circuit) starts by the degree counters of verteces
+// A circuit starts at the first vertex by name having edges, nil if the graph has no edge
+// A path which is not a circuit starts at the vertex with one more out edge, or the first odd vertex of undirected graph
+func eulerianStart(g GraphInterface, circuit bool) (VertexInterface, error) {
+	undirected, err := undirectedEdges(g)
+	if err != nil {
+		return nil, err
+	}
+
+	verteces, _ := indexVerteces(g)
+	var first, start VertexInterface
+	odd, in, out := 0, 0, 0
+	for _, v := range verteces {
+		if len(v.Edges()) == 0 {
+			continue
+		}
+		if first == nil {
+			first = v
+		}
+
+		// undirected edges count in both degrees
+		if undirected {
+			if v.Indegree()%2 == 1 {
+				odd++
+				if start == nil {
+					start = v
+				}
+			}
+			continue
+		}
+
+		switch v.Outdegree() - v.Indegree() {
+		case 0:
+		case 1:
+			out++
+			start = v
+		case -1:
+			in++
+		default:
+			return nil, fmt.Errorf("graph[name:%s] has no eulerian path, vertex[name:%s] has %d in and %d out edges!",
+				g.Name(), v.Name(), v.Indegree(), v.Outdegree())
+		}
+	}
+
+	if odd > 2 || in > 1 || out > 1 {
+		return nil, fmt.Errorf("graph[name:%s] has no eulerian path, unbalanced degrees!", g.Name())
+	}
+	if start == nil {
+		start = first
+	} else if circuit {
+		return nil, fmt.Errorf("graph[name:%s] has no eulerian circuit, unbalanced degrees!", g.Name())
+	}
+
+	if !edgesConnected(g, first) {
+		return nil, fmt.Errorf("graph[name:%s] has no eulerian path, edges are not connected!", g.Name())
+	}
+
+	return start, nil
+}
+
+// Determine if all edges of a graph are undirected, or all are directed
+func undirectedEdges(g GraphInterface) (bool, error) {
+	directed, undirected := false, false
+	for _, ei := range edgeList(g) {
+		if ei.Type() == UndirectedEdge {
+			undirected = true
+		} else {
+			directed = true
+		}
+	}
+
+	if directed && undirected {
+		return false, fmt.Errorf("graph[name:%s] mixes directed and undirected edges!", g.Name())
+	}
+	return undirected, nil
+}
+
+// Determine if every vertex with edges can be reached from root, ignoring edge directions
+func edgesConnected(g GraphInterface, root VertexInterface) bool {
+	if root == nil {
+		return true
+	}
+
+	visited := map[string]bool{root.Name(): true}
+	stack := []VertexInterface{root}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, ei := range v.Edges() {
+			if adj := adjacentVertex(v, ei); !visited[adj.Name()] {
+				visited[adj.Name()] = true
+				stack = append(stack, adj)
+			}
+		}
+	}
+
+	for _, v := range g.Verteces() {
+		if len(v.Edges()) > 0 && !visited[v.Name()] {
+			return false
+		}
+	}
+	return true
+}
+
+// Eulerian walk of a graph from start, see hierholzerWalk
+func hierholzer(g GraphInterface, start VertexInterface) ([]EdgeInterface, []VertexInterface) {
+	if start == nil {
+		return nil, nil
+	}
+
+	// edges leaving every vertex, an undirected edge leaves both endpoints
+	verteces, index := indexVerteces(g)
+	edges := edgeList(g)
+	arcs := make([][]eulerArc, len(verteces))
+	for k, ei := range edges {
+		from, to := index[ei.From().Name()], index[ei.To().Name()]
+		arcs[from] = append(arcs[from], eulerArc{edge: k, to: to})
+		if ei.Type() == UndirectedEdge && from != to {
+			arcs[to] = append(arcs[to], eulerArc{edge: k, to: from})
+		}
+	}
+
+	edgeSeq, vertexSeq := hierholzerWalk(arcs, len(edges), index[start.Name()])
+
+	path := make([]EdgeInterface, 0, len(edgeSeq))
+	for _, k := range edgeSeq {
+		path = append(path, edges[k])
+	}
+	walked := make([]VertexInterface, 0, len(vertexSeq))
+	for _, v := range vertexSeq {
+		walked = append(walked, verteces[v])
+	}
+
+	return path, walked
+}
+
+// edge k leaving a vertex to another one
+type eulerArc struct {
+	edge, to int
+}
+
+// Hierholzer walk over arcs of m edges from start, the degrees must allow an eulerian path from it
+// Walk on and splice in a circuit whenever the walk gets stuck at a vertex with unused edges
+// Return the edges in walking order and the verteces walked through
+func hierholzerWalk(arcs [][]eulerArc, m int, start int) ([]int, []int) {
+	used := make([]bool, m)
+	next := make([]int, len(arcs))
+
+	type step struct {
+		v, edge int
+	}
+	stack := []step{{start, -1}}
+	var walked []step
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		v := top.v
+		for next[v] < len(arcs[v]) && used[arcs[v][next[v]].edge] {
+			next[v]++
+		}
+
+		if next[v] == len(arcs[v]) {
+			// stuck, the step is final
+			walked = append(walked, top)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		a := arcs[v][next[v]]
+		used[a.edge] = true
+		stack = append(stack, step{a.to, a.edge})
+	}
+
+	// steps are finished backward
+	edges := make([]int, 0, m)
+	verteces := make([]int, 0, len(walked))
+	for i := len(walked) - 1; i >= 0; i-- {
+		verteces = append(verteces, walked[i].v)
+		if walked[i].edge >= 0 {
+			edges = append(edges, walked[i].edge)
+		}
+	}
+
+	return edges, verteces
+}
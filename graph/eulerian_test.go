@@ -0,0 +1,136 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test4EulerianPath_Undirected(t *testing.T) {
+	// bowtie: triangles a-b-c and c-d-e, all degrees even
+	g := createUndirectedLinkGraph4Test(t, "a-b b-c c-a c-d d-e e-c")
+	if !HasEulerianCircuit(g) || !HasEulerianPath(g) {
+		t.Fatal("bowtie should have an eulerian circuit")
+	}
+	edges, verteces, err := EulerianCircuit(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEulerian(t, g, edges, verteces, true)
+	checkPath(t, verteces, "a", "b", "c", "d", "e", "c", "a")
+
+	// a and d become odd
+	g.InsertEdgeByName("a", "d", NewEdge(1, UndirectedEdge))
+	if HasEulerianCircuit(g) || !HasEulerianPath(g) {
+		t.Fatal("graph should have an eulerian path only")
+	}
+	edges, verteces, err = EulerianPath(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEulerian(t, g, edges, verteces, false)
+	if verteces[0].Name() != "a" || verteces[len(verteces)-1].Name() != "d" {
+		t.Errorf("eulerian path should go from a to d, %s", cycleString(verteces))
+	}
+	if _, _, err := EulerianCircuit(g); err == nil {
+		t.Error("eulerian circuit should not exist")
+	}
+
+	// a self loop counts twice in the degree
+	loop := createUndirectedLinkGraph4Test(t, "a-a a-b")
+	edges, verteces, err = EulerianPath(loop)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEulerian(t, loop, edges, verteces, false)
+	checkPath(t, verteces, "a", "a", "b")
+
+	// edges in two parts, or more than two odd verteces
+	if HasEulerianPath(createUndirectedLinkGraph4Test(t, "a-b b-c c-a d-e e-f f-d")) {
+		t.Error("disconnected edges should have no eulerian path")
+	}
+	if HasEulerianPath(createUndirectedLinkGraph4Test(t, "a-b a-c a-d")) {
+		t.Error("star should have no eulerian path")
+	}
+
+	// isolated verteces do not matter
+	g = createUndirectedLinkGraph4Test(t, "a-b b-c c-a")
+	g.InsertVertex(NewVertex("z", 0))
+	if !HasEulerianCircuit(g) {
+		t.Error("isolated vertex should be ignored")
+	}
+}
+
+func Test4EulerianPath_Directed(t *testing.T) {
+	g := createLinkGraph4Test(t, splitLinks("a->b b->c c->a c->d d->e e->c"))
+	edges, verteces, err := EulerianCircuit(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEulerian(t, g, edges, verteces, true)
+
+	// c -> f makes c the start and f the end
+	g.InsertVertex(NewVertex("f", 0))
+	g.InsertEdgeByName("c", "f", NewEdge(1, BackwardEdge))
+	if HasEulerianCircuit(g) || !HasEulerianPath(g) {
+		t.Fatal("graph should have an eulerian path only")
+	}
+	edges, verteces, _ = EulerianPath(g)
+	checkEulerian(t, g, edges, verteces, false)
+	if verteces[0].Name() != "c" || verteces[len(verteces)-1].Name() != "f" {
+		t.Errorf("eulerian path should go from c to f, %s", cycleString(verteces))
+	}
+
+	if HasEulerianPath(createLinkGraph4Test(t, splitLinks("a->b a->c"))) {
+		t.Error("a with 2 out edges should have no eulerian path")
+	}
+
+	edges, verteces, err = EulerianCircuit(NewDirectedGraph("Empty"))
+	if err != nil || len(edges) != 0 || len(verteces) != 0 {
+		t.Error("empty graph should have an empty eulerian circuit")
+	}
+}
+
+// Check that the walk uses every edge once, and each edge joins the verteces around it
+func checkEulerian(t *testing.T, g GraphInterface, edges []EdgeInterface, verteces []VertexInterface, circuit bool) {
+	all := edgeList(g)
+	if len(edges) != len(all) || len(verteces) != len(edges)+1 {
+		t.Fatalf("walk of %d edges and %d verteces, expected %d edges", len(edges), len(verteces), len(all))
+	}
+
+	used := make(map[EdgeInterface]bool)
+	for i, ei := range edges {
+		if used[ei] {
+			t.Errorf("edge %s is walked twice", edgeName(ei))
+		}
+		used[ei] = true
+
+		from, to := verteces[i].Name(), verteces[i+1].Name()
+		forward := ei.From().Name() == from && ei.To().Name() == to
+		backward := ei.Type() == UndirectedEdge && ei.From().Name() == to && ei.To().Name() == from
+		if !forward && !backward {
+			t.Errorf("edge %s does not join %s and %s", edgeName(ei), from, to)
+		}
+	}
+
+	if circuit && verteces[0].Name() != verteces[len(verteces)-1].Name() {
+		t.Errorf("walk %s is not a circuit", cycleString(verteces))
+	}
+}
+
+/// create undirected graph for test from edges given as 'from-to' items
+func createUndirectedLinkGraph4Test(t *testing.T, links string) *UndirectedGraph {
+	g := NewUndirectedGraph("UndirectedLinkGraph")
+	for _, link := range strings.Fields(links) {
+		names := strings.Split(link, "-")
+		for _, name := range names {
+			if g.GetVertex(name) == nil {
+				g.InsertVertex(NewVertex(name, 0))
+			}
+		}
+		if g.InsertEdgeByName(names[0], names[1], NewEdge(1, UndirectedEdge)) != nil {
+			t.Error("InsertEdge error")
+		}
+	}
+
+	return g
+}
@@ -0,0 +1,297 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	simpleSt "graph/simplestructure"
+)
+
+// tolerance to compare float flows
+const flowEpsilon = 1e-9
+
+/**********************************************************************************/
+// maximum flow
+/**********************************************************************************/
+
+// result of a flow computation
+type FlowResult struct {
+	// total flow from source to sink
+	Value float64
+	// flow through each edge, Flow[from][to] keyed by vertex names
+	// an undirected edge is keyed by the direction its flow takes
+	Flow map[string]map[string]float64
+	// minimum s-t cut: verteces still reachable from source in the residual graph, and the others
+	SourceSide []VertexInterface
+	SinkSide   []VertexInterface
+	// edges going from source side to sink side, saturated by the flow
+	CutEdges []EdgeInterface
+}
+
+// Maximum flow from src to sink by Dinic's algorithm, using edge weights as capacities
+// An undirected edge carries flow in either direction up to its weight
+// The minimum s-t cut comes with the flow
+func MaxFlow(g GraphInterface, src, sink VertexInterface) (*FlowResult, error) {
+	network, err := newMaxFlowNetwork(g, src, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	s, t := network.index[src.Name()], network.index[sink.Name()]
+	value := 0.0
+	for network.levelGraph(s, t) {
+		next := make([]int, len(network.arcs))
+		for {
+			f := network.blockingFlow(s, t, math.Inf(1), next)
+			if f <= flowEpsilon {
+				break
+			}
+			value += f
+		}
+	}
+
+	return network.result(s, value), nil
+}
+
+// Maximum flow from src to sink by Edmonds-Karp algorithm, the reference implementation of MaxFlow
+func EdmondsKarp(g GraphInterface, src, sink VertexInterface) (*FlowResult, error) {
+	network, err := newMaxFlowNetwork(g, src, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	s, t := network.index[src.Name()], network.index[sink.Name()]
+	value := 0.0
+	for {
+		// shortest augmenting path by bfs, prev holds the arc reaching each vertex
+		prev := make([]*flowArc, len(network.arcs))
+		queue := simpleSt.NewSimpleQueue()
+		queue.Pushback(s)
+		for prev[t] == nil {
+			item := queue.Popfront()
+			if item == nil {
+				break
+			}
+			v := item.(int)
+			for i := range network.arcs[v] {
+				arc := &network.arcs[v][i]
+				if arc.to != s && prev[arc.to] == nil && arc.residual() > flowEpsilon {
+					prev[arc.to] = arc
+					queue.Pushback(arc.to)
+				}
+			}
+		}
+
+		if prev[t] == nil {
+			break
+		}
+
+		f := math.Inf(1)
+		for v := t; v != s; v = network.arcs[prev[v].to][prev[v].rev].to {
+			f = math.Min(f, prev[v].residual())
+		}
+		for v := t; v != s; v = network.arcs[prev[v].to][prev[v].rev].to {
+			network.push(prev[v], f)
+		}
+		value += f
+	}
+
+	return network.result(s, value), nil
+}
+
+/**********************************************************************************/
+// flow network
+/**********************************************************************************/
+
+// arc of the residual network
+type flowArc struct {
+	to int
+	// index of the paired arc in arcs[to]
+	rev      int
+	capacity float64
+	flow     float64
+	cost     float64
+	// original edge, nil for the paired arc of a directed edge
+	edge EdgeInterface
+}
+
+func (arc *flowArc) residual() float64 {
+	return arc.capacity - arc.flow
+}
+
+// residual network over indexed verteces
+type flowNetwork struct {
+	verteces []VertexInterface
+	index    map[string]int
+	arcs     [][]flowArc
+	// bfs level of each vertex, used by dinic
+	level []int
+}
+
+func newMaxFlowNetwork(g GraphInterface, src, sink VertexInterface) (*flowNetwork, error) {
+	if err := checkVertex(g, src); err != nil {
+		return nil, err
+	}
+
+	if err := checkVertex(g, sink); err != nil {
+		return nil, err
+	}
+
+	if src.Name() == sink.Name() {
+		return nil, fmt.Errorf("source and sink are the same vertex[name:%s]!", src.Name())
+	}
+
+	if err := checkNonNegativeWeights(g, "max flow"); err != nil {
+		return nil, err
+	}
+
+	network := newFlowNetwork(g)
+	for _, ei := range edgeList(g) {
+		u, v := network.index[ei.From().Name()], network.index[ei.To().Name()]
+		if ei.Type() == UndirectedEdge {
+			network.addArcs(u, v, float64(ei.Weight()), float64(ei.Weight()), 0, ei)
+		} else {
+			network.addArcs(u, v, float64(ei.Weight()), 0, 0, ei)
+		}
+	}
+
+	return network, nil
+}
+
+func newFlowNetwork(g GraphInterface) *flowNetwork {
+	verteces, index := indexVerteces(g)
+	return &flowNetwork{
+		verteces: verteces,
+		index:    index,
+		arcs:     make([][]flowArc, len(verteces)),
+		level:    make([]int, len(verteces)),
+	}
+}
+
+// add an arc u->v for edge ei, paired with the arc v->u of capacity reverseCapacity and negative cost
+func (network *flowNetwork) addArcs(u, v int, capacity, reverseCapacity, cost float64, ei EdgeInterface) {
+	network.arcs[u] = append(network.arcs[u], flowArc{
+		to: v, rev: len(network.arcs[v]), capacity: capacity, cost: cost, edge: ei,
+	})
+	network.arcs[v] = append(network.arcs[v], flowArc{
+		to: u, rev: len(network.arcs[u]) - 1, capacity: reverseCapacity, cost: -cost,
+	})
+}
+
+// push flow along an arc and take it back from the paired arc
+func (network *flowNetwork) push(arc *flowArc, f float64) {
+	arc.flow += f
+	network.arcs[arc.to][arc.rev].flow -= f
+}
+
+// build bfs levels over residual arcs, false if t can not be reached from s
+func (network *flowNetwork) levelGraph(s, t int) bool {
+	for i := range network.level {
+		network.level[i] = -1
+	}
+	network.level[s] = 0
+
+	queue := simpleSt.NewSimpleQueue()
+	queue.Pushback(s)
+	for {
+		item := queue.Popfront()
+		if item == nil {
+			break
+		}
+		v := item.(int)
+		for _, arc := range network.arcs[v] {
+			if network.level[arc.to] == -1 && arc.residual() > flowEpsilon {
+				network.level[arc.to] = network.level[v] + 1
+				queue.Pushback(arc.to)
+			}
+		}
+	}
+
+	return network.level[t] != -1
+}
+
+// find an augmenting path along increasing levels by dfs and push at most limit through it
+// next keeps the first arc of each vertex which may still lead to t
+func (network *flowNetwork) blockingFlow(v, t int, limit float64, next []int) float64 {
+	if v == t {
+		return limit
+	}
+
+	for ; next[v] < len(network.arcs[v]); next[v]++ {
+		arc := &network.arcs[v][next[v]]
+		if network.level[arc.to] != network.level[v]+1 || arc.residual() <= flowEpsilon {
+			continue
+		}
+
+		if f := network.blockingFlow(arc.to, t, math.Min(limit, arc.residual()), next); f > flowEpsilon {
+			network.push(arc, f)
+			return f
+		}
+	}
+
+	return 0
+}
+
+// collect flows of the original edges and the minimum cut
+func (network *flowNetwork) result(s int, value float64) *FlowResult {
+	result := &FlowResult{
+		Value: value,
+		Flow:  make(map[string]map[string]float64),
+	}
+
+	setFlow := func(from, to string, f float64) {
+		if _, ok := result.Flow[from]; !ok {
+			result.Flow[from] = make(map[string]float64)
+		}
+		result.Flow[from][to] = f
+	}
+
+	// residual reachability from source
+	reachable := make([]bool, len(network.verteces))
+	reachable[s] = true
+	queue := simpleSt.NewSimpleQueue()
+	queue.Pushback(s)
+	for {
+		item := queue.Popfront()
+		if item == nil {
+			break
+		}
+		for _, arc := range network.arcs[item.(int)] {
+			if !reachable[arc.to] && arc.residual() > flowEpsilon {
+				reachable[arc.to] = true
+				queue.Pushback(arc.to)
+			}
+		}
+	}
+
+	for u, arcs := range network.arcs {
+		for _, arc := range arcs {
+			if arc.edge == nil {
+				continue
+			}
+
+			from, to := network.verteces[u].Name(), network.verteces[arc.to].Name()
+			if arc.flow < 0 {
+				// undirected edge used backward
+				setFlow(to, from, -arc.flow)
+			} else {
+				setFlow(from, to, arc.flow)
+			}
+
+			if reachable[u] && !reachable[arc.to] ||
+				arc.edge.Type() == UndirectedEdge && !reachable[u] && reachable[arc.to] {
+				result.CutEdges = append(result.CutEdges, arc.edge)
+			}
+		}
+	}
+
+	for i, v := range network.verteces {
+		if reachable[i] {
+			result.SourceSide = append(result.SourceSide, v)
+		} else {
+			result.SinkSide = append(result.SinkSide, v)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,118 @@
+package graph
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func Test4MaxFlow(t *testing.T) {
+	g := createFlowGraph4Test(t)
+
+	for name, maxFlow := range map[string]func(GraphInterface, VertexInterface, VertexInterface) (*FlowResult, error){
+		"dinic":        MaxFlow,
+		"edmonds-karp": EdmondsKarp,
+	} {
+		result, err := maxFlow(g, g.GetVertex("s"), g.GetVertex("t"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result.Value != 23 {
+			t.Errorf("%s flow is %v, expected 23", name, result.Value)
+		}
+		checkFlow(t, g, result, "s", "t")
+
+		var sourceSide []string
+		for _, v := range result.SourceSide {
+			sourceSide = append(sourceSide, v.Name())
+		}
+		if strings.Join(sourceSide, ",") != "s,v1,v2,v4" {
+			t.Errorf("%s source side is %v", name, sourceSide)
+		}
+
+		cut := 0.0
+		for _, ei := range result.CutEdges {
+			cut += float64(ei.Weight())
+		}
+		if len(result.CutEdges) != 3 || cut != result.Value {
+			t.Errorf("%s cut has %d edges with capacity %v", name, len(result.CutEdges), cut)
+		}
+	}
+}
+
+func Test4MaxFlow_Undirected(t *testing.T) {
+	g := createWeightedUndirectedGraph4Test(t)
+
+	// a - b - d - e bottlenecked by d-e
+	result, err := MaxFlow(g, g.GetVertex("a"), g.GetVertex("e"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Value != 3 {
+		t.Errorf("flow is %v, expected 3", result.Value)
+	}
+	checkFlow(t, g, result, "a", "e")
+
+	if _, err := MaxFlow(g, g.GetVertex("a"), g.GetVertex("a")); err == nil {
+		t.Error("source and sink should differ")
+	}
+}
+
+// check capacities and flow conservation
+func checkFlow(t *testing.T, g GraphInterface, result *FlowResult, src, sink string) {
+	balance := make(map[string]float64)
+	for from, flows := range result.Flow {
+		for to, f := range flows {
+			if f < 0 || f > edgeCost(g.GetVertex(from), g.GetVertex(to))+flowEpsilon {
+				t.Errorf("flow %s -> %s is %v, out of capacity", from, to, f)
+			}
+			balance[from] -= f
+			balance[to] += f
+		}
+	}
+
+	var names []string
+	for name := range g.Verteces() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		expected := 0.0
+		switch name {
+		case src:
+			expected = -result.Value
+		case sink:
+			expected = result.Value
+		}
+		if math.Abs(balance[name]-expected) > flowEpsilon {
+			t.Errorf("flow balance of %s is %v, expected %v", name, balance[name], expected)
+		}
+	}
+}
+
+/// create the flow network for test
+// s->v1:16, s->v2:13, v1->v3:12, v2->v1:4, v2->v4:14, v3->v2:9, v3->t:20, v4->v3:7, v4->t:4
+func createFlowGraph4Test(t *testing.T) *DirectedGraph {
+	g := NewDirectedGraph("FlowGraph")
+	for _, name := range []string{"s", "v1", "v2", "v3", "v4", "t"} {
+		if g.InsertVertex(NewVertex(name, 0)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	if g.InsertEdgeByName("s", "v1", NewEdge(16, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("s", "v2", NewEdge(13, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("v1", "v3", NewEdge(12, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("v2", "v1", NewEdge(4, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("v2", "v4", NewEdge(14, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("v3", "v2", NewEdge(9, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("v3", "t", NewEdge(20, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("v4", "v3", NewEdge(7, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("v4", "t", NewEdge(4, BackwardEdge)) != nil {
+		t.Error("InsertEdge error")
+	}
+
+	return g
+}
@@ -0,0 +1,157 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+/**********************************************************************************/
+// weighted bipartite matching
+/**********************************************************************************/
+
+// Hungarian algorithm for the weighted matching of a bipartite graph
+// The cost matrix is built from the edges, the two sides come from IsBipartite
+// Among the matchings of maximum cardinality, the one with minimum total weight is returned,
+// or maximum total weight if maximize is set
+// Return the matched edges and their total weight
+func HungarianMatching(g GraphInterface, maximize bool) ([]EdgeInterface, float64, error) {
+	colors, oddCycle, ok := IsBipartite(g)
+	if !ok {
+		return nil, 0, fmt.Errorf("graph[name:%s] is not bipartite, odd cycle: %s", g.Name(), cycleString(oddCycle))
+	}
+
+	verteces, _ := indexVerteces(g)
+	var left, right []VertexInterface
+	for _, v := range verteces {
+		if colors[v.Name()] == 0 {
+			left = append(left, v)
+		} else {
+			right = append(right, v)
+		}
+	}
+
+	n := len(left)
+	if len(right) > n {
+		n = len(right)
+	}
+	if n == 0 {
+		return nil, 0, nil
+	}
+
+	rightIndex := make(map[string]int)
+	for j, v := range right {
+		rightIndex[v.Name()] = j
+	}
+
+	// square cost matrix, missing pairs stay nil
+	edges := make([][]EdgeInterface, n)
+	maxAbs := 0.0
+	for i := range edges {
+		edges[i] = make([]EdgeInterface, n)
+	}
+	for i, v := range left {
+		for _, ei := range v.Edges() {
+			j := rightIndex[adjacentVertex(v, ei).Name()]
+			edges[i][j] = ei
+			maxAbs = math.Max(maxAbs, math.Abs(float64(ei.Weight())))
+		}
+	}
+
+	// a missing pair costs more than any difference between real matchings,
+	// so every extra real edge is preferred
+	missing := 2*float64(n)*maxAbs + 1
+	cost := make([][]float64, n)
+	for i := range cost {
+		cost[i] = make([]float64, n)
+		for j := range cost[i] {
+			switch {
+			case edges[i][j] == nil:
+				cost[i][j] = missing
+			case maximize:
+				cost[i][j] = -float64(edges[i][j].Weight())
+			default:
+				cost[i][j] = float64(edges[i][j].Weight())
+			}
+		}
+	}
+
+	var matching []EdgeInterface
+	total := 0.0
+	for i, j := range hungarian(cost) {
+		if ei := edges[i][j]; ei != nil {
+			matching = append(matching, ei)
+			total += float64(ei.Weight())
+		}
+	}
+
+	return matching, total, nil
+}
+
+// Hungarian algorithm with potentials over a square cost matrix, O(n^3)
+// Return the column assigned to each row with minimum total cost
+func hungarian(cost [][]float64) []int {
+	n := len(cost)
+	// potentials of rows and columns, index 0 is a virtual column
+	u := make([]float64, n+1)
+	v := make([]float64, n+1)
+	// p[j] is the row assigned to column j, way[j] the previous column on the augmenting path
+	p := make([]int, n+1)
+	way := make([]int, n+1)
+
+	for i := 1; i <= n; i++ {
+		p[0] = i
+		j0 := 0
+		minv := make([]float64, n+1)
+		used := make([]bool, n+1)
+		for j := range minv {
+			minv[j] = math.Inf(1)
+		}
+
+		for {
+			used[j0] = true
+			i0, j1 := p[j0], 0
+			delta := math.Inf(1)
+			for j := 1; j <= n; j++ {
+				if used[j] {
+					continue
+				}
+				if cur := cost[i0-1][j-1] - u[i0] - v[j]; cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+
+			for j := 0; j <= n; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+
+		// flip the augmenting path
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	assignment := make([]int, n)
+	for j := 1; j <= n; j++ {
+		assignment[p[j]-1] = j - 1
+	}
+
+	return assignment
+}
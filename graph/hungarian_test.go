@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"testing"
+)
+
+func Test4HungarianMatching(t *testing.T) {
+	// a, b, c to x, y, z with costs
+	// a: 4 1 3
+	// b: 2 0 5
+	// c: 3 2 2
+	g := NewUndirectedGraph("AssignmentGraph")
+	for _, name := range []string{"a", "b", "c", "x", "y", "z"} {
+		g.InsertVertex(NewVertex(name, 0))
+	}
+	costs := map[string][]float32{"a": {4, 1, 3}, "b": {2, 0, 5}, "c": {3, 2, 2}}
+	for from, row := range costs {
+		for j, to := range []string{"x", "y", "z"} {
+			if g.InsertEdgeByName(from, to, NewEdge(row[j], UndirectedEdge)) != nil {
+				t.Error("InsertEdge error")
+			}
+		}
+	}
+
+	matching, total, err := HungarianMatching(g, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matching) != 3 || total != 5 {
+		t.Errorf("min matching has %d edges with weight %v, expected 3 edges with weight 5", len(matching), total)
+	}
+
+	matching, total, err = HungarianMatching(g, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matching) != 3 || total != 11 {
+		t.Errorf("max matching has %d edges with weight %v, expected 3 edges with weight 11", len(matching), total)
+	}
+}
+
+func Test4HungarianMatching_Sparse(t *testing.T) {
+	// missing pairs must not be matched, and the matching must stay maximum
+	g := createJobGraph4Test(t)
+	g.InsertVertex(NewVertex("j5", 0))
+	g.InsertEdgeByName("w2", "j5", NewEdge(1, UndirectedEdge))
+
+	matching, total, err := HungarianMatching(g, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// w4-j3:3 is forced, and w2-j5:1 with w1, w3 matched for 4 is the cheapest rest
+	if len(matching) != 4 || total != 8 {
+		t.Errorf("matching has %d edges with weight %v, expected 4 edges with weight 8", len(matching), total)
+	}
+	for _, ei := range matching {
+		t.Logf("%s - %s: %v", ei.From().Name(), ei.To().Name(), ei.Weight())
+	}
+
+	if _, _, err := HungarianMatching(createNetworkGraph4Test(t), false); err == nil {
+		t.Error("network graph is not bipartite")
+	}
+}
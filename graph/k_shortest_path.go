@@ -0,0 +1,165 @@
+package graph
+
+import (
+	"math"
+)
+
+/**********************************************************************************/
+// path struct
+/**********************************************************************************/
+
+// a walk through the graph and its total weight
+type Path struct {
+	Verteces []VertexInterface
+	Cost     float64
+}
+
+// Get names of the verteces on the path
+func (p Path) Names() []string {
+	names := make([]string, 0, len(p.Verteces))
+	for _, v := range p.Verteces {
+		names = append(names, v.Name())
+	}
+	return names
+}
+
+// Determine if two paths walk through the same verteces
+func (p Path) Equal(other Path) bool {
+	if len(p.Verteces) != len(other.Verteces) {
+		return false
+	}
+
+	for i, v := range p.Verteces {
+		if v.Name() != other.Verteces[i].Name() {
+			return false
+		}
+	}
+
+	return true
+}
+
+/**********************************************************************************/
+// k shortest paths
+/**********************************************************************************/
+
+// Yen's k shortest loopless paths from src to dst, ordered by cost
+// Fewer than k paths are returned if the graph does not have enough simple paths
+// Weights must be non-negative
+func KShortestPaths(g GraphInterface, src, dst VertexInterface, k int) ([]Path, error) {
+	if err := checkVertex(g, src); err != nil {
+		return nil, err
+	}
+
+	if err := checkVertex(g, dst); err != nil {
+		return nil, err
+	}
+
+	if err := checkNonNegativeWeights(g, "yen's k shortest paths"); err != nil {
+		return nil, err
+	}
+
+	var paths []Path
+	if k <= 0 {
+		return paths, nil
+	}
+
+	sp := dijkstra(g, src, edgeWeight)
+	if math.IsInf(sp.DistTo(dst), 1) {
+		return paths, nil
+	}
+	paths = append(paths, Path{Verteces: sp.PathTo(dst), Cost: sp.DistTo(dst)})
+
+	// candidates for the next shortest path
+	var candidates []Path
+	for len(paths) < k {
+		last := paths[len(paths)-1].Verteces
+
+		for i := 0; i < len(last)-1; i++ {
+			spur := last[i]
+			root := last[:i+1]
+
+			// forbid the edges leaving the spur vertex along known paths sharing the same root
+			blockedEdges := make(map[[2]string]bool)
+			for _, p := range paths {
+				if len(p.Verteces) > i+1 && (Path{Verteces: p.Verteces[:i+1]}).Equal(Path{Verteces: root}) {
+					blockedEdges[[2]string{p.Verteces[i].Name(), p.Verteces[i+1].Name()}] = true
+				}
+			}
+
+			// forbid the root verteces to keep the path loopless
+			blockedVerteces := make(map[string]bool)
+			for _, v := range root[:i] {
+				blockedVerteces[v.Name()] = true
+			}
+
+			weight := func(from VertexInterface, ei EdgeInterface) float64 {
+				to := adjacentVertex(from, ei)
+				if blockedVerteces[to.Name()] || blockedEdges[[2]string{from.Name(), to.Name()}] {
+					return math.Inf(1)
+				}
+				return float64(ei.Weight())
+			}
+
+			spurPaths := dijkstra(g, spur, weight)
+			spurPath := spurPaths.PathTo(dst)
+			if spurPath == nil {
+				continue
+			}
+
+			candidate := Path{
+				Verteces: append(append([]VertexInterface{}, root[:i]...), spurPath...),
+				Cost:     pathCost(root) + spurPaths.DistTo(dst),
+			}
+			if !containsPath(paths, candidate) && !containsPath(candidates, candidate) {
+				candidates = append(candidates, candidate)
+			}
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		// move the cheapest candidate, prefer fewer verteces on ties
+		best := 0
+		for i, c := range candidates {
+			if c.Cost < candidates[best].Cost ||
+				(c.Cost == candidates[best].Cost && len(c.Verteces) < len(candidates[best].Verteces)) {
+				best = i
+			}
+		}
+		paths = append(paths, candidates[best])
+		candidates = append(candidates[:best], candidates[best+1:]...)
+	}
+
+	return paths, nil
+}
+
+// Sum the weights along a vertex sequence, using the cheapest edge between neighbours
+// +Inf if two neighbours are not adjacent
+func pathCost(verteces []VertexInterface) float64 {
+	cost := 0.0
+	for i := 0; i+1 < len(verteces); i++ {
+		cost += edgeCost(verteces[i], verteces[i+1])
+	}
+	return cost
+}
+
+// Get the weight of the cheapest edge walked from 'from' to 'to', +Inf if there is none
+func edgeCost(from, to VertexInterface) float64 {
+	cost := math.Inf(1)
+	for _, ei := range from.EdgesBackward() {
+		if adjacentVertex(from, ei).Name() == to.Name() && float64(ei.Weight()) < cost {
+			cost = float64(ei.Weight())
+		}
+	}
+	return cost
+}
+
+func containsPath(paths []Path, p Path) bool {
+	for _, other := range paths {
+		if other.Equal(p) {
+			return true
+		}
+	}
+	return false
+}
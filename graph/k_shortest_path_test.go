@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test4KShortestPaths(t *testing.T) {
+	g := createYenGraph4Test(t)
+
+	paths, err := KShortestPaths(g, g.GetVertex("C"), g.GetVertex("H"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		names string
+		cost  float64
+	}{
+		{"C E F H", 5},
+		{"C E G H", 7},
+		{"C D F H", 8},
+	}
+	if len(paths) != len(expected) {
+		t.Fatalf("got %d paths, expected %d", len(paths), len(expected))
+	}
+	for i, p := range paths {
+		names := strings.Join(p.Names(), " ")
+		t.Logf("%s: %v", names, p.Cost)
+		if names != expected[i].names || p.Cost != expected[i].cost {
+			t.Errorf("path[%d] is %s(%v), expected %s(%v)", i, names, p.Cost, expected[i].names, expected[i].cost)
+		}
+	}
+}
+
+func Test4KShortestPaths_NotEnough(t *testing.T) {
+	g := createYenGraph4Test(t)
+
+	// there are only 7 simple paths from C to H
+	paths, err := KShortestPaths(g, g.GetVertex("C"), g.GetVertex("H"), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 7 {
+		t.Errorf("got %d paths, expected 7", len(paths))
+	}
+	for i := 1; i < len(paths); i++ {
+		if paths[i].Cost < paths[i-1].Cost {
+			t.Error("paths should be ordered by cost")
+		}
+	}
+
+	paths, err = KShortestPaths(g, g.GetVertex("H"), g.GetVertex("C"), 3)
+	if err != nil || len(paths) != 0 {
+		t.Error("C is unreachable from H")
+	}
+}
+
+/// create the directed graph used by the example of yen's algorithm
+// C->D:3, C->E:2, D->F:4, E->D:1, E->F:2, E->G:3, F->G:2, F->H:1, G->H:2
+func createYenGraph4Test(t *testing.T) *DirectedGraph {
+	g := NewDirectedGraph("YenGraph")
+	for _, name := range []string{"C", "D", "E", "F", "G", "H"} {
+		if g.InsertVertex(NewVertex(name, 0)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	if g.InsertEdgeByName("C", "D", NewEdge(3, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("C", "E", NewEdge(2, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("D", "F", NewEdge(4, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("E", "D", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("E", "F", NewEdge(2, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("E", "G", NewEdge(3, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("F", "G", NewEdge(2, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("F", "H", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("G", "H", NewEdge(2, BackwardEdge)) != nil {
+		t.Error("InsertEdge error")
+	}
+
+	return g
+}
@@ -0,0 +1,803 @@
+package graph
+
+/**********************************************************************************/
+// general graph matching
+/**********************************************************************************/
+
+// Edmonds' blossom algorithm for the maximum cardinality matching of an undirected graph
+// Return the matched edges
+func MaximumMatching(g GraphInterface) ([]EdgeInterface, error) {
+	if err := checkUndirectedEdges(g, "blossom matching"); err != nil {
+		return nil, err
+	}
+
+	verteces, index := indexVerteces(g)
+	adj := make([][]int, len(verteces))
+	for i, v := range verteces {
+		for _, ei := range v.Edges() {
+			if j := index[adjacentVertex(v, ei).Name()]; j != i {
+				adj[i] = append(adj[i], j)
+			}
+		}
+	}
+
+	mate := blossomMatching(adj)
+
+	var matching []EdgeInterface
+	for i, v := range verteces {
+		if j := mate[i]; j > i {
+			matching = append(matching, v.FindEdge(verteces[j], UndirectedEdge))
+		}
+	}
+
+	return matching, nil
+}
+
+// Edmonds' blossom algorithm over adjacency lists, O(V^3)
+// Return the mate of each vertex, -1 if unmatched
+func blossomMatching(adj [][]int) []int {
+	n := len(adj)
+	mate := make([]int, n)
+	for i := range mate {
+		mate[i] = -1
+	}
+
+	// parent on the alternating tree, base of the blossom containing each vertex
+	parent := make([]int, n)
+	base := make([]int, n)
+	used := make([]bool, n)
+	inBlossom := make([]bool, n)
+
+	// lowest common ancestor of two outer verteces in the alternating tree
+	lca := func(a, b int) int {
+		seen := make([]bool, n)
+		for {
+			a = base[a]
+			seen[a] = true
+			if mate[a] == -1 {
+				break
+			}
+			a = parent[mate[a]]
+		}
+		for {
+			b = base[b]
+			if seen[b] {
+				return b
+			}
+			b = parent[mate[b]]
+		}
+	}
+
+	// mark the blossom path from v down to base b, linking verteces back through child
+	markPath := func(v, b, child int) {
+		for base[v] != b {
+			inBlossom[base[v]] = true
+			inBlossom[base[mate[v]]] = true
+			parent[v] = child
+			child = mate[v]
+			v = parent[mate[v]]
+		}
+	}
+
+	// grow an alternating tree from root, return the free vertex reached or -1
+	findPath := func(root int) int {
+		for i := 0; i < n; i++ {
+			used[i] = false
+			parent[i] = -1
+			base[i] = i
+		}
+
+		used[root] = true
+		queue := []int{root}
+		for head := 0; head < len(queue); head++ {
+			v := queue[head]
+			for _, to := range adj[v] {
+				if base[v] == base[to] || mate[v] == to {
+					continue
+				}
+
+				if to == root || (mate[to] != -1 && parent[mate[to]] != -1) {
+					// odd cycle: shrink the blossom
+					b := lca(v, to)
+					for i := range inBlossom {
+						inBlossom[i] = false
+					}
+					markPath(v, b, to)
+					markPath(to, b, v)
+					for i := 0; i < n; i++ {
+						if inBlossom[base[i]] {
+							base[i] = b
+							if !used[i] {
+								used[i] = true
+								queue = append(queue, i)
+							}
+						}
+					}
+				} else if parent[to] == -1 {
+					parent[to] = v
+					if mate[to] == -1 {
+						return to
+					}
+					used[mate[to]] = true
+					queue = append(queue, mate[to])
+				}
+			}
+		}
+
+		return -1
+	}
+
+	for root := 0; root < n; root++ {
+		if mate[root] != -1 {
+			continue
+		}
+
+		// flip the augmenting path
+		for v := findPath(root); v != -1; {
+			pv := parent[v]
+			next := mate[pv]
+			mate[v] = pv
+			mate[pv] = v
+			v = next
+		}
+	}
+
+	return mate
+}
+
+/**********************************************************************************/
+// general graph weighted matching
+/**********************************************************************************/
+
+// tolerance to compare float dual variables
+const matchingEpsilon = 1e-9
+
+// Edmonds' blossom algorithm with dual variables for the maximum weight matching of an undirected graph
+// If maxCardinality is set, only matchings of maximum cardinality are considered
+// Return the matched edges and their total weight
+func MaximumWeightMatching(g GraphInterface, maxCardinality bool) ([]EdgeInterface, float64, error) {
+	if err := checkUndirectedEdges(g, "blossom matching"); err != nil {
+		return nil, 0, err
+	}
+
+	verteces, index := indexVerteces(g)
+	var edges []EdgeInterface
+	var pairs []weightedPair
+	for _, ei := range edgeList(g) {
+		i, j := index[ei.From().Name()], index[ei.To().Name()]
+		if i == j {
+			continue
+		}
+		edges = append(edges, ei)
+		pairs = append(pairs, weightedPair{i: i, j: j, weight: float64(ei.Weight())})
+	}
+
+	mate := newWeightedMatching(len(verteces), pairs).solve(maxCardinality)
+
+	var matching []EdgeInterface
+	total := 0.0
+	for k, pair := range pairs {
+		if mate[pair.i] == pair.j {
+			matching = append(matching, edges[k])
+			total += pair.weight
+		}
+	}
+
+	return matching, total, nil
+}
+
+// an edge between two indexed verteces
+type weightedPair struct {
+	i, j   int
+	weight float64
+}
+
+// state of the weighted blossom algorithm
+// Verteces are 0..n-1 and blossoms n..2n-1, an edge k has endpoints 2k and 2k+1
+type weightedMatching struct {
+	n     int
+	edges []weightedPair
+	// vertex of each endpoint, and the remote endpoints of each vertex
+	endpoint  []int
+	neighbend [][]int
+	// remote endpoint of the matched edge of each vertex, -1 if single
+	mate []int
+	// label of top-level blossoms and verteces: 0 free, 1 S, 2 T, and bit 4 as breadcrumb
+	label []int
+	// endpoint through which a labeled blossom got its label
+	labelend []int
+	// top-level blossom containing each vertex
+	inblossom []int
+	// blossom tree
+	blossomparent []int
+	blossomchilds [][]int
+	blossombase   []int
+	blossomendps  [][]int
+	// least-slack edge to an S-blossom
+	bestedge         []int
+	blossombestedges [][]int
+	unusedblossoms   []int
+	dualvar          []float64
+	allowedge        []bool
+	queue            []int
+}
+
+func newWeightedMatching(n int, edges []weightedPair) *weightedMatching {
+	m := &weightedMatching{
+		n:                n,
+		edges:            edges,
+		endpoint:         make([]int, 2*len(edges)),
+		neighbend:        make([][]int, n),
+		mate:             make([]int, n),
+		label:            make([]int, 2*n),
+		labelend:         make([]int, 2*n),
+		inblossom:        make([]int, n),
+		blossomparent:    make([]int, 2*n),
+		blossomchilds:    make([][]int, 2*n),
+		blossombase:      make([]int, 2*n),
+		blossomendps:     make([][]int, 2*n),
+		bestedge:         make([]int, 2*n),
+		blossombestedges: make([][]int, 2*n),
+		dualvar:          make([]float64, 2*n),
+		allowedge:        make([]bool, len(edges)),
+	}
+
+	maxWeight := 0.0
+	for k, e := range edges {
+		m.endpoint[2*k] = e.i
+		m.endpoint[2*k+1] = e.j
+		m.neighbend[e.i] = append(m.neighbend[e.i], 2*k+1)
+		m.neighbend[e.j] = append(m.neighbend[e.j], 2*k)
+		if e.weight > maxWeight {
+			maxWeight = e.weight
+		}
+	}
+
+	for v := 0; v < n; v++ {
+		m.mate[v] = -1
+		m.inblossom[v] = v
+		m.dualvar[v] = maxWeight
+	}
+	for b := 0; b < 2*n; b++ {
+		m.labelend[b] = -1
+		m.blossomparent[b] = -1
+		m.bestedge[b] = -1
+		if b < n {
+			m.blossombase[b] = b
+		} else {
+			m.blossombase[b] = -1
+			m.unusedblossoms = append(m.unusedblossoms, b)
+		}
+	}
+
+	return m
+}
+
+// twice the slack of edge k
+func (m *weightedMatching) slack(k int) float64 {
+	e := m.edges[k]
+	return m.dualvar[e.i] + m.dualvar[e.j] - 2*e.weight
+}
+
+// verteces inside blossom b
+func (m *weightedMatching) blossomLeaves(b int) []int {
+	if b < m.n {
+		return []int{b}
+	}
+
+	var leaves []int
+	for _, t := range m.blossomchilds[b] {
+		leaves = append(leaves, m.blossomLeaves(t)...)
+	}
+	return leaves
+}
+
+// label the top-level blossom of w with t through endpoint p
+func (m *weightedMatching) assignLabel(w, t, p int) {
+	b := m.inblossom[w]
+	m.label[w], m.label[b] = t, t
+	m.labelend[w], m.labelend[b] = p, p
+	m.bestedge[w], m.bestedge[b] = -1, -1
+
+	if t == 1 {
+		m.queue = append(m.queue, m.blossomLeaves(b)...)
+	} else if t == 2 {
+		// the mate of a T-blossom base becomes S
+		base := m.blossombase[b]
+		m.assignLabel(m.endpoint[m.mate[base]], 1, m.mate[base]^1)
+	}
+}
+
+// trace back from v and w to find a new blossom, return its base or -1 for an augmenting path
+func (m *weightedMatching) scanBlossom(v, w int) int {
+	var path []int
+	base := -1
+	for v != -1 || w != -1 {
+		b := m.inblossom[v]
+		if m.label[b]&4 != 0 {
+			base = m.blossombase[b]
+			break
+		}
+
+		path = append(path, b)
+		m.label[b] = 5
+		if m.labelend[b] == -1 {
+			v = -1
+		} else {
+			v = m.endpoint[m.labelend[b]]
+			b = m.inblossom[v]
+			v = m.endpoint[m.labelend[b]]
+		}
+
+		// alternate between both paths
+		if w != -1 {
+			v, w = w, v
+		}
+	}
+
+	for _, b := range path {
+		m.label[b] = 1
+	}
+
+	return base
+}
+
+// shrink the blossom made by edge k with the given base
+func (m *weightedMatching) addBlossom(base, k int) {
+	v, w := m.edges[k].i, m.edges[k].j
+	bb, bv, bw := m.inblossom[base], m.inblossom[v], m.inblossom[w]
+
+	b := m.unusedblossoms[len(m.unusedblossoms)-1]
+	m.unusedblossoms = m.unusedblossoms[:len(m.unusedblossoms)-1]
+	m.blossombase[b] = base
+	m.blossomparent[b] = -1
+	m.blossomparent[bb] = b
+
+	var path, endps []int
+	for bv != bb {
+		m.blossomparent[bv] = b
+		path = append(path, bv)
+		endps = append(endps, m.labelend[bv])
+		v = m.endpoint[m.labelend[bv]]
+		bv = m.inblossom[v]
+	}
+	path = append(path, bb)
+	reverseInts(path)
+	reverseInts(endps)
+	endps = append(endps, 2*k)
+	for bw != bb {
+		m.blossomparent[bw] = b
+		path = append(path, bw)
+		endps = append(endps, m.labelend[bw]^1)
+		w = m.endpoint[m.labelend[bw]]
+		bw = m.inblossom[w]
+	}
+	m.blossomchilds[b] = path
+	m.blossomendps[b] = endps
+
+	m.label[b] = 1
+	m.labelend[b] = m.labelend[bb]
+	m.dualvar[b] = 0
+	for _, leaf := range m.blossomLeaves(b) {
+		if m.label[m.inblossom[leaf]] == 2 {
+			// former T-verteces become S inside the blossom
+			m.queue = append(m.queue, leaf)
+		}
+		m.inblossom[leaf] = b
+	}
+
+	// least-slack edges from the new blossom to other S-blossoms
+	bestedgeto := make([]int, 2*m.n)
+	for i := range bestedgeto {
+		bestedgeto[i] = -1
+	}
+	for _, sub := range path {
+		var nblists [][]int
+		if m.blossombestedges[sub] == nil {
+			for _, leaf := range m.blossomLeaves(sub) {
+				var nblist []int
+				for _, p := range m.neighbend[leaf] {
+					nblist = append(nblist, p/2)
+				}
+				nblists = append(nblists, nblist)
+			}
+		} else {
+			nblists = [][]int{m.blossombestedges[sub]}
+		}
+
+		for _, nblist := range nblists {
+			for _, e := range nblist {
+				i, j := m.edges[e].i, m.edges[e].j
+				if m.inblossom[j] == b {
+					i, j = j, i
+				}
+				bj := m.inblossom[j]
+				if bj != b && m.label[bj] == 1 &&
+					(bestedgeto[bj] == -1 || m.slack(e) < m.slack(bestedgeto[bj])) {
+					bestedgeto[bj] = e
+				}
+			}
+		}
+		m.blossombestedges[sub] = nil
+		m.bestedge[sub] = -1
+	}
+
+	m.blossombestedges[b] = []int{}
+	for _, e := range bestedgeto {
+		if e != -1 {
+			m.blossombestedges[b] = append(m.blossombestedges[b], e)
+		}
+	}
+	m.bestedge[b] = -1
+	for _, e := range m.blossombestedges[b] {
+		if m.bestedge[b] == -1 || m.slack(e) < m.slack(m.bestedge[b]) {
+			m.bestedge[b] = e
+		}
+	}
+}
+
+// expand blossom b into its sub-blossoms
+func (m *weightedMatching) expandBlossom(b int, endstage bool) {
+	for _, s := range m.blossomchilds[b] {
+		m.blossomparent[s] = -1
+		if s < m.n {
+			m.inblossom[s] = s
+		} else if endstage && m.dualvar[s] <= matchingEpsilon {
+			m.expandBlossom(s, endstage)
+		} else {
+			for _, leaf := range m.blossomLeaves(s) {
+				m.inblossom[leaf] = s
+			}
+		}
+	}
+
+	if !endstage && m.label[b] == 2 {
+		// relabel the sub-blossoms on the even path from the entry child to the base
+		childs := m.blossomchilds[b]
+		entrychild := m.inblossom[m.endpoint[m.labelend[b]^1]]
+		j := indexOfInt(childs, entrychild)
+		jstep, endptrick := -1, 1
+		if j&1 != 0 {
+			j -= len(childs)
+			jstep, endptrick = 1, 0
+		}
+		at := func(j int) int {
+			return childs[(j+len(childs))%len(childs)]
+		}
+		endpAt := func(j int) int {
+			endps := m.blossomendps[b]
+			return endps[(j+len(endps))%len(endps)]
+		}
+
+		p := m.labelend[b]
+		for j != 0 {
+			m.label[m.endpoint[p^1]] = 0
+			m.label[m.endpoint[endpAt(j-endptrick)^endptrick^1]] = 0
+			m.assignLabel(m.endpoint[p^1], 2, p)
+			m.allowedge[endpAt(j-endptrick)/2] = true
+			j += jstep
+			p = endpAt(j-endptrick) ^ endptrick
+			m.allowedge[p/2] = true
+			j += jstep
+		}
+
+		bv := at(j)
+		m.label[m.endpoint[p^1]], m.label[bv] = 2, 2
+		m.labelend[m.endpoint[p^1]], m.labelend[bv] = p, p
+		m.bestedge[bv] = -1
+		j += jstep
+		for at(j) != entrychild {
+			bv = at(j)
+			if m.label[bv] == 1 {
+				j += jstep
+				continue
+			}
+
+			// a sub-blossom reachable through a T-vertex keeps its label
+			labeled := -1
+			for _, leaf := range m.blossomLeaves(bv) {
+				if m.label[leaf] != 0 {
+					labeled = leaf
+					break
+				}
+			}
+			if labeled != -1 {
+				m.label[labeled] = 0
+				m.label[m.endpoint[m.mate[m.blossombase[bv]]]] = 0
+				m.assignLabel(labeled, 2, m.labelend[labeled])
+			}
+			j += jstep
+		}
+	}
+
+	m.label[b], m.labelend[b] = -1, -1
+	m.blossomchilds[b], m.blossomendps[b] = nil, nil
+	m.blossombase[b] = -1
+	m.blossombestedges[b] = nil
+	m.bestedge[b] = -1
+	m.unusedblossoms = append(m.unusedblossoms, b)
+}
+
+// swap matched and unmatched edges inside blossom b so that vertex v becomes its base
+func (m *weightedMatching) augmentBlossom(b, v int) {
+	t := v
+	for m.blossomparent[t] != b {
+		t = m.blossomparent[t]
+	}
+	if t >= m.n {
+		m.augmentBlossom(t, v)
+	}
+
+	childs := m.blossomchilds[b]
+	endps := m.blossomendps[b]
+	at := func(j int) int {
+		return childs[(j+len(childs))%len(childs)]
+	}
+	endpAt := func(j int) int {
+		return endps[(j+len(endps))%len(endps)]
+	}
+
+	i := indexOfInt(childs, t)
+	j := i
+	jstep, endptrick := -1, 1
+	if i&1 != 0 {
+		j -= len(childs)
+		jstep, endptrick = 1, 0
+	}
+
+	for j != 0 {
+		j += jstep
+		t = at(j)
+		p := endpAt(j-endptrick) ^ endptrick
+		if t >= m.n {
+			m.augmentBlossom(t, m.endpoint[p])
+		}
+		j += jstep
+		t = at(j)
+		if t >= m.n {
+			m.augmentBlossom(t, m.endpoint[p^1])
+		}
+		m.mate[m.endpoint[p]] = p ^ 1
+		m.mate[m.endpoint[p^1]] = p
+	}
+
+	// rotate so that the new base comes first
+	m.blossomchilds[b] = append(append([]int{}, childs[i:]...), childs[:i]...)
+	m.blossomendps[b] = append(append([]int{}, endps[i:]...), endps[:i]...)
+	m.blossombase[b] = m.blossombase[m.blossomchilds[b][0]]
+}
+
+// augment the matching along the path through edge k
+func (m *weightedMatching) augmentMatching(k int) {
+	for _, start := range [][2]int{{m.edges[k].i, 2*k + 1}, {m.edges[k].j, 2 * k}} {
+		s, p := start[0], start[1]
+		for {
+			bs := m.inblossom[s]
+			if bs >= m.n {
+				m.augmentBlossom(bs, s)
+			}
+			m.mate[s] = p
+			if m.labelend[bs] == -1 {
+				break
+			}
+
+			t := m.endpoint[m.labelend[bs]]
+			bt := m.inblossom[t]
+			s = m.endpoint[m.labelend[bt]]
+			j := m.endpoint[m.labelend[bt]^1]
+			if bt >= m.n {
+				m.augmentBlossom(bt, j)
+			}
+			m.mate[j] = m.labelend[bt]
+			p = m.labelend[bt] ^ 1
+		}
+	}
+}
+
+// run the stages of the algorithm, return the mate vertex of each vertex or -1
+func (m *weightedMatching) solve(maxCardinality bool) []int {
+	n := m.n
+	for stage := 0; stage < n; stage++ {
+		for i := range m.label {
+			m.label[i] = 0
+			m.bestedge[i] = -1
+		}
+		for b := n; b < 2*n; b++ {
+			m.blossombestedges[b] = nil
+		}
+		for i := range m.allowedge {
+			m.allowedge[i] = false
+		}
+		m.queue = m.queue[:0]
+
+		for v := 0; v < n; v++ {
+			if m.mate[v] == -1 && m.label[m.inblossom[v]] == 0 {
+				m.assignLabel(v, 1, -1)
+			}
+		}
+
+		augmented := false
+		for {
+			for len(m.queue) > 0 && !augmented {
+				v := m.queue[len(m.queue)-1]
+				m.queue = m.queue[:len(m.queue)-1]
+
+				for _, p := range m.neighbend[v] {
+					k := p / 2
+					w := m.endpoint[p]
+					if m.inblossom[v] == m.inblossom[w] {
+						continue
+					}
+
+					kslack := 0.0
+					if !m.allowedge[k] {
+						kslack = m.slack(k)
+						if kslack <= matchingEpsilon {
+							m.allowedge[k] = true
+						}
+					}
+
+					if m.allowedge[k] {
+						if m.label[m.inblossom[w]] == 0 {
+							m.assignLabel(w, 2, p^1)
+						} else if m.label[m.inblossom[w]] == 1 {
+							if base := m.scanBlossom(v, w); base >= 0 {
+								m.addBlossom(base, k)
+							} else {
+								m.augmentMatching(k)
+								augmented = true
+								break
+							}
+						} else if m.label[w] == 0 {
+							m.label[w] = 2
+							m.labelend[w] = p ^ 1
+						}
+					} else if m.label[m.inblossom[w]] == 1 {
+						b := m.inblossom[v]
+						if m.bestedge[b] == -1 || kslack < m.slack(m.bestedge[b]) {
+							m.bestedge[b] = k
+						}
+					} else if m.label[w] == 0 {
+						if m.bestedge[w] == -1 || kslack < m.slack(m.bestedge[w]) {
+							m.bestedge[w] = k
+						}
+					}
+				}
+			}
+
+			if augmented {
+				break
+			}
+
+			// no augmenting path with tight edges, update the dual variables
+			deltatype := -1
+			delta := 0.0
+			deltaedge, deltablossom := -1, -1
+
+			if !maxCardinality {
+				deltatype = 1
+				delta = m.dualvar[0]
+				for v := 1; v < n; v++ {
+					if m.dualvar[v] < delta {
+						delta = m.dualvar[v]
+					}
+				}
+			}
+
+			for v := 0; v < n; v++ {
+				if m.label[m.inblossom[v]] == 0 && m.bestedge[v] != -1 {
+					if d := m.slack(m.bestedge[v]); deltatype == -1 || d < delta {
+						delta = d
+						deltatype = 2
+						deltaedge = m.bestedge[v]
+					}
+				}
+			}
+
+			for b := 0; b < 2*n; b++ {
+				if m.blossomparent[b] == -1 && m.label[b] == 1 && m.bestedge[b] != -1 {
+					if d := m.slack(m.bestedge[b]) / 2; deltatype == -1 || d < delta {
+						delta = d
+						deltatype = 3
+						deltaedge = m.bestedge[b]
+					}
+				}
+			}
+
+			for b := n; b < 2*n; b++ {
+				if m.blossombase[b] >= 0 && m.blossomparent[b] == -1 && m.label[b] == 2 &&
+					(deltatype == -1 || m.dualvar[b] < delta) {
+					delta = m.dualvar[b]
+					deltatype = 4
+					deltablossom = b
+				}
+			}
+
+			if deltatype == -1 {
+				// no further improvement is possible with max cardinality, do a final update
+				deltatype = 1
+				delta = m.dualvar[0]
+				for v := 1; v < n; v++ {
+					if m.dualvar[v] < delta {
+						delta = m.dualvar[v]
+					}
+				}
+				if delta < 0 {
+					delta = 0
+				}
+			}
+
+			for v := 0; v < n; v++ {
+				switch m.label[m.inblossom[v]] {
+				case 1:
+					m.dualvar[v] -= delta
+				case 2:
+					m.dualvar[v] += delta
+				}
+			}
+			for b := n; b < 2*n; b++ {
+				if m.blossombase[b] >= 0 && m.blossomparent[b] == -1 {
+					switch m.label[b] {
+					case 1:
+						m.dualvar[b] += delta
+					case 2:
+						m.dualvar[b] -= delta
+					}
+				}
+			}
+
+			if deltatype == 1 {
+				break
+			} else if deltatype == 2 {
+				m.allowedge[deltaedge] = true
+				i, j := m.edges[deltaedge].i, m.edges[deltaedge].j
+				if m.label[m.inblossom[i]] == 0 {
+					i, j = j, i
+				}
+				m.queue = append(m.queue, i)
+			} else if deltatype == 3 {
+				m.allowedge[deltaedge] = true
+				m.queue = append(m.queue, m.edges[deltaedge].i)
+			} else {
+				m.expandBlossom(deltablossom, false)
+			}
+		}
+
+		if !augmented {
+			break
+		}
+
+		// expand S-blossoms with zero dual at the end of a stage
+		for b := n; b < 2*n; b++ {
+			if m.blossomparent[b] == -1 && m.blossombase[b] >= 0 && m.label[b] == 1 &&
+				m.dualvar[b] <= matchingEpsilon {
+				m.expandBlossom(b, true)
+			}
+		}
+	}
+
+	mate := make([]int, n)
+	for v := 0; v < n; v++ {
+		mate[v] = -1
+		if m.mate[v] >= 0 {
+			mate[v] = m.endpoint[m.mate[v]]
+		}
+	}
+
+	return mate
+}
+
+func reverseInts(list []int) {
+	for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+		list[i], list[j] = list[j], list[i]
+	}
+}
+
+func indexOfInt(list []int, x int) int {
+	for i, y := range list {
+		if x == y {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,157 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func Test4MaximumMatching(t *testing.T) {
+	// two triangles joined by c-d: a perfect matching needs the odd cycles to be handled
+	g := createNetworkGraph4Test(t)
+
+	matching, err := MaximumMatching(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkMatching(t, matching)
+	// a-b c-d e-f g-h, i is isolated
+	if len(matching) != 4 {
+		t.Errorf("matching size is %d, expected 4", len(matching))
+	}
+
+	if _, err := MaximumMatching(createDirectedGraph4Test(t)); err == nil {
+		t.Error("directed graph should be rejected.")
+	}
+}
+
+func Test4MaximumMatching_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		g := createRandomGraph4Test(t, rnd, 8, 0.4)
+
+		matching, err := MaximumMatching(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkMatching(t, matching)
+
+		if expected := bruteForceMatching(edgeList(g), map[string]bool{}, false); float64(len(matching)) != expected {
+			t.Errorf("round %d: matching size is %d, expected %v", round, len(matching), expected)
+		}
+	}
+}
+
+func Test4MaximumWeightMatching(t *testing.T) {
+	// the heavy middle edge loses against the two side edges
+	g := NewUndirectedGraph("PathGraph")
+	for _, name := range []string{"a", "b", "c", "d"} {
+		g.InsertVertex(NewVertex(name, 0))
+	}
+	g.InsertEdgeByName("a", "b", NewEdge(5, UndirectedEdge))
+	g.InsertEdgeByName("b", "c", NewEdge(8, UndirectedEdge))
+	g.InsertEdgeByName("c", "d", NewEdge(5, UndirectedEdge))
+
+	matching, total, err := MaximumWeightMatching(g, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkMatching(t, matching)
+	if len(matching) != 2 || total != 10 {
+		t.Errorf("matching has %d edges with weight %v, expected 2 edges with weight 10", len(matching), total)
+	}
+
+	g.GetVertex("a").FindEdge(g.GetVertex("b"), UndirectedEdge).SetWeight(3)
+	if _, total, _ := MaximumWeightMatching(g, false); total != 8 {
+		t.Errorf("matching weight is %v, expected 8", total)
+	}
+	if matching, total, _ := MaximumWeightMatching(g, true); len(matching) != 2 || total != 8 {
+		t.Errorf("max cardinality matching has %d edges with weight %v, expected 2 edges with weight 8", len(matching), total)
+	}
+}
+
+func Test4MaximumWeightMatching_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	for round := 0; round < 100; round++ {
+		g := createRandomGraph4Test(t, rnd, 2+rnd.Intn(7), 0.5)
+		edges := edgeList(g)
+
+		matching, total, err := MaximumWeightMatching(g, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkMatching(t, matching)
+		if expected := bruteForceMatching(edges, map[string]bool{}, true); total != expected {
+			t.Errorf("round %d: matching weight is %v, expected %v", round, total, expected)
+		}
+
+		matching, total, err = MaximumWeightMatching(g, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkMatching(t, matching)
+		if expected := bruteForceMatching(edges, map[string]bool{}, false); float64(len(matching)) != expected {
+			t.Errorf("round %d: max cardinality matching size is %d, expected %v", round, len(matching), expected)
+		}
+	}
+}
+
+// check that matched edges do not share verteces
+func checkMatching(t *testing.T, matching []EdgeInterface) {
+	matched := make(map[string]bool)
+	for _, ei := range matching {
+		if ei == nil || matched[ei.From().Name()] || matched[ei.To().Name()] || ei.From().Name() == ei.To().Name() {
+			t.Errorf("invalid matching edge %v", ei)
+			continue
+		}
+		matched[ei.From().Name()] = true
+		matched[ei.To().Name()] = true
+	}
+}
+
+// best matching by trying every edge subset, counting edges or summing weights
+func bruteForceMatching(edges []EdgeInterface, matched map[string]bool, weighted bool) float64 {
+	if len(edges) == 0 {
+		return 0
+	}
+
+	ei, rest := edges[0], edges[1:]
+	best := bruteForceMatching(rest, matched, weighted)
+	if !matched[ei.From().Name()] && !matched[ei.To().Name()] {
+		matched[ei.From().Name()], matched[ei.To().Name()] = true, true
+		value := 1.0
+		if weighted {
+			value = float64(ei.Weight())
+		}
+		if taken := value + bruteForceMatching(rest, matched, weighted); taken > best {
+			best = taken
+		}
+		delete(matched, ei.From().Name())
+		delete(matched, ei.To().Name())
+	}
+
+	return best
+}
+
+/// create random undirected graph for test, weights are integers in [1, 20]
+func createRandomGraph4Test(t *testing.T, rnd *rand.Rand, n int, density float64) *UndirectedGraph {
+	g := NewUndirectedGraph("RandomGraph")
+	for i := 0; i < n; i++ {
+		if g.InsertVertex(NewVertex(fmt.Sprintf("v%d", i), i)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if rnd.Float64() < density {
+				w := float32(rnd.Intn(20) + 1)
+				if g.InsertEdgeByName(fmt.Sprintf("v%d", i), fmt.Sprintf("v%d", j), NewEdge(w, UndirectedEdge)) != nil {
+					t.Error("InsertEdge error")
+				}
+			}
+		}
+	}
+
+	return g
+}
@@ -0,0 +1,207 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	simpleSt "graph/simplestructure"
+)
+
+/**********************************************************************************/
+// cost edge
+/**********************************************************************************/
+
+// edge carrying a capacity and a unit cost, its weight is the cost
+type CostEdge struct {
+	*AbstractEdge
+	capacity float32
+}
+
+func NewCostEdge(capacity, cost float32, edgeType EdgeType) *CostEdge {
+	return &CostEdge{
+		AbstractEdge: NewEdge(cost, edgeType),
+		capacity:     capacity,
+	}
+}
+
+func (e *CostEdge) Capacity() float32 {
+	return e.capacity
+}
+
+func (e *CostEdge) Cost() float32 {
+	return e.Weight()
+}
+
+func (e *CostEdge) Copy() EdgeInterface {
+	return &CostEdge{
+		AbstractEdge: e.AbstractEdge.Copy().(*AbstractEdge),
+		capacity:     e.capacity,
+	}
+}
+
+/**********************************************************************************/
+// minimum cost flow
+/**********************************************************************************/
+
+// accessor reading a value from an edge
+type EdgeValueFunc func(EdgeInterface) float64
+
+// Minimum cost flow from src to sink by successive shortest paths with potentials
+// Capacity and cost of an edge are read by the accessors, a nil accessor reads the attribute of CostEdge,
+// and capacity falls back to the edge weight for other edges
+// demand limits the flow to send, non-positive means as much as possible (min-cost max-flow)
+// The cut fields of the result only form a minimum cut when the flow is maximum
+// Return the flow and its total cost
+func MinCostFlow(g GraphInterface, src, sink VertexInterface, demand float64, capacity, cost EdgeValueFunc) (
+	*FlowResult, float64, error) {
+	if err := checkVertex(g, src); err != nil {
+		return nil, 0, err
+	}
+
+	if err := checkVertex(g, sink); err != nil {
+		return nil, 0, err
+	}
+
+	if src.Name() == sink.Name() {
+		return nil, 0, fmt.Errorf("source and sink are the same vertex[name:%s]!", src.Name())
+	}
+
+	if capacity == nil {
+		capacity = func(ei EdgeInterface) float64 {
+			if ce, ok := ei.(*CostEdge); ok {
+				return float64(ce.Capacity())
+			}
+			return float64(ei.Weight())
+		}
+	}
+
+	if cost == nil {
+		cost = func(ei EdgeInterface) float64 {
+			if ce, ok := ei.(*CostEdge); ok {
+				return float64(ce.Cost())
+			}
+			return math.NaN()
+		}
+	}
+
+	network := newFlowNetwork(g)
+	for _, ei := range edgeList(g) {
+		if ei.Type() == UndirectedEdge {
+			return nil, 0, fmt.Errorf("Edge type(%s) wrong! min cost flow requires directed edges.", ei.Type())
+		}
+
+		c, w := capacity(ei), cost(ei)
+		if c < 0 || math.IsNaN(c) || math.IsInf(c, 1) {
+			return nil, 0, fmt.Errorf("edge[%s -> %s] has invalid capacity(%v)!", ei.From().Name(), ei.To().Name(), c)
+		}
+		if math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, 0, fmt.Errorf("edge[%s -> %s] has no cost, use CostEdge or give a cost accessor!",
+				ei.From().Name(), ei.To().Name())
+		}
+
+		network.addArcs(network.index[ei.From().Name()], network.index[ei.To().Name()], c, 0, w, ei)
+	}
+
+	potential, err := network.initialPotential()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	s, t := network.index[src.Name()], network.index[sink.Name()]
+	if demand <= 0 {
+		demand = math.Inf(1)
+	}
+
+	value, totalCost := 0.0, 0.0
+	for demand-value > flowEpsilon {
+		dist, prev := network.reducedShortestPaths(s, potential)
+		if prev[t] == nil {
+			break
+		}
+
+		for v := range potential {
+			if !math.IsInf(dist[v], 1) {
+				potential[v] += dist[v]
+			}
+		}
+
+		f := demand - value
+		for v := t; v != s; v = network.arcs[prev[v].to][prev[v].rev].to {
+			f = math.Min(f, prev[v].residual())
+		}
+		for v := t; v != s; v = network.arcs[prev[v].to][prev[v].rev].to {
+			network.push(prev[v], f)
+			totalCost += f * prev[v].cost
+		}
+		value += f
+	}
+
+	return network.result(s, value), totalCost, nil
+}
+
+// potentials making every residual arc cost non-negative, by bellman-ford from a virtual source
+func (network *flowNetwork) initialPotential() ([]float64, error) {
+	n := len(network.verteces)
+	potential := make([]float64, n)
+	for i := 0; i < n; i++ {
+		updated := false
+		for u, arcs := range network.arcs {
+			for _, arc := range arcs {
+				if arc.residual() > flowEpsilon && potential[u]+arc.cost < potential[arc.to] {
+					potential[arc.to] = potential[u] + arc.cost
+					updated = true
+				}
+			}
+		}
+
+		if !updated {
+			return potential, nil
+		}
+	}
+
+	return nil, fmt.Errorf("negative cost cycle found, min cost flow is unbounded!")
+}
+
+// dijkstra over residual arcs with costs reduced by potentials
+// Return distances and the arc reaching each vertex, nil for unreachable ones
+func (network *flowNetwork) reducedShortestPaths(s int, potential []float64) ([]float64, []*flowArc) {
+	n := len(network.verteces)
+	dist := make([]float64, n)
+	prev := make([]*flowArc, n)
+	done := make([]bool, n)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	dist[s] = 0
+
+	pq := simpleSt.NewSimplePriorityQueue()
+	pq.Push(s, 0)
+	for {
+		item := pq.Pop()
+		if item == nil {
+			break
+		}
+		u := item.(int)
+		if done[u] {
+			continue
+		}
+		done[u] = true
+
+		for i := range network.arcs[u] {
+			arc := &network.arcs[u][i]
+			if arc.residual() <= flowEpsilon || done[arc.to] {
+				continue
+			}
+
+			// guard against rounding, the reduced cost is never negative
+			reduced := math.Max(0, arc.cost+potential[u]-potential[arc.to])
+			if d := dist[u] + reduced; d < dist[arc.to] {
+				dist[arc.to] = d
+				prev[arc.to] = arc
+				pq.Push(arc.to, d)
+			}
+		}
+	}
+
+	return dist, prev
+}
@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"testing"
+)
+
+func Test4MinCostFlow(t *testing.T) {
+	g := createCostFlowGraph4Test(t)
+
+	result, cost, err := MinCostFlow(g, g.GetVertex("s"), g.GetVertex("t"), 0, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Value != 3 || cost != 10 {
+		t.Errorf("flow is %v with cost %v, expected 3 with cost 10", result.Value, cost)
+	}
+	if result.Flow["a"]["t"] != 1 || result.Flow["a"]["b"] != 1 || result.Flow["b"]["t"] != 2 {
+		t.Errorf("unexpected flow assignment %v", result.Flow)
+	}
+
+	// a single unit takes one of the paths costing 3
+	result, cost, err = MinCostFlow(g, g.GetVertex("s"), g.GetVertex("t"), 1, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Value != 1 || cost != 3 {
+		t.Errorf("flow is %v with cost %v, expected 1 with cost 3", result.Value, cost)
+	}
+}
+
+func Test4MinCostFlow_Accessor(t *testing.T) {
+	// plain edges: weight is the capacity, costs come from the caller
+	g := createFlowGraph4Test(t)
+	costs := map[[2]string]float64{{"s", "v1"}: 1, {"v3", "t"}: -2}
+	cost := func(ei EdgeInterface) float64 {
+		return costs[[2]string{ei.From().Name(), ei.To().Name()}]
+	}
+
+	result, total, err := MinCostFlow(g, g.GetVertex("s"), g.GetVertex("t"), 0, nil, cost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the max flow needs 19 units through the refund v3->t, and at least 10 through s->v1
+	if result.Value != 23 || total != -28 {
+		t.Errorf("flow is %v with cost %v, expected 23 with cost -28", result.Value, total)
+	}
+	checkFlow(t, g, result, "s", "t")
+
+	if _, _, err := MinCostFlow(g, g.GetVertex("s"), g.GetVertex("t"), 0, nil, nil); err != nil {
+		t.Log(err)
+	} else {
+		t.Error("plain edges have no cost")
+	}
+}
+
+/// create the cost flow network for test, edges are capacity/cost
+// s->a:2/1, s->b:1/2, a->b:1/1, a->t:1/3, b->t:2/1
+func createCostFlowGraph4Test(t *testing.T) *DirectedGraph {
+	g := NewDirectedGraph("CostFlowGraph")
+	for _, name := range []string{"s", "a", "b", "t"} {
+		if g.InsertVertex(NewVertex(name, 0)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	if g.InsertEdgeByName("s", "a", NewCostEdge(2, 1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("s", "b", NewCostEdge(1, 2, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("a", "b", NewCostEdge(1, 1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("a", "t", NewCostEdge(1, 3, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("b", "t", NewCostEdge(2, 1, BackwardEdge)) != nil {
+		t.Error("InsertEdge error")
+	}
+
+	return g
+}
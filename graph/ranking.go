@@ -0,0 +1,264 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+/**********************************************************************************/
+// link analysis
+/**********************************************************************************/
+
+// options of the link analysis algorithms, zero values take the defaults
+type RankOptions struct {
+	// probability to follow a link instead of teleporting, in (0, 1) and 0.85 by default (PageRank only)
+	// 0 takes the default as well, so pure teleporting can not be asked for
+	Damping float64
+	// convergence threshold on the total change of scores per vertex, 1e-6 by default
+	Tolerance float64
+	// iterations before giving up, 100 by default
+	MaxIterations int
+	// follow links in proportion to edge weights, every link counts the same otherwise
+	Weighted bool
+	// where verteces without out links send their score, by vertex name (PageRank only)
+	// nil sends it by the teleport distribution
+	Dangling map[string]float64
+}
+
+func DefaultRankOptions() RankOptions {
+	return RankOptions{
+		Damping:       0.85,
+		Tolerance:     1e-6,
+		MaxIterations: 100,
+	}
+}
+
+func (opts RankOptions) withDefaults() RankOptions {
+	defaults := DefaultRankOptions()
+	if opts.Damping == 0 {
+		opts.Damping = defaults.Damping
+	}
+	if opts.Tolerance == 0 {
+		opts.Tolerance = defaults.Tolerance
+	}
+	if opts.MaxIterations == 0 {
+		opts.MaxIterations = defaults.MaxIterations
+	}
+	return opts
+}
+
+// PageRank scores of verteces by power iteration, summing to 1
+// Undirected edges are links both ways
+func PageRank(g GraphInterface, opts RankOptions) (map[string]float64, error) {
+	return PersonalizedPageRank(g, nil, opts)
+}
+
+// Personalized PageRank: random jumps land on verteces by the teleport distribution
+// teleport is keyed by vertex name and normalized, missing verteces get 0, nil means uniform
+func PersonalizedPageRank(g GraphInterface, teleport map[string]float64, opts RankOptions) (map[string]float64, error) {
+	opts = opts.withDefaults()
+	if opts.Damping <= 0 || opts.Damping >= 1 {
+		return nil, fmt.Errorf("damping(%v) out of range (0, 1)!", opts.Damping)
+	}
+
+	verteces, index := indexVerteces(g)
+	n := len(verteces)
+	if n == 0 {
+		return map[string]float64{}, nil
+	}
+
+	adj, err := rankAdjacency(g, verteces, index, opts.Weighted)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := distribution(teleport, index, "teleport")
+	if err != nil {
+		return nil, err
+	}
+	dangling := p
+	if opts.Dangling != nil {
+		if dangling, err = distribution(opts.Dangling, index, "dangling"); err != nil {
+			return nil, err
+		}
+	}
+
+	// total out weight of each vertex
+	outWeight := make([]float64, n)
+	for v, arcs := range adj {
+		for _, arc := range arcs {
+			outWeight[v] += arc.weight
+		}
+	}
+
+	x := append([]float64{}, p...)
+	for iter := 0; iter < opts.MaxIterations; iter++ {
+		last := x
+		x = make([]float64, n)
+
+		danglingSum := 0.0
+		for v := range last {
+			if outWeight[v] == 0 {
+				danglingSum += last[v]
+			}
+		}
+
+		for v, arcs := range adj {
+			// links of zero total weight are dangling, counted above
+			if outWeight[v] == 0 {
+				continue
+			}
+			for _, arc := range arcs {
+				x[arc.to] += opts.Damping * last[v] * arc.weight / outWeight[v]
+			}
+		}
+
+		change := 0.0
+		for i := range x {
+			x[i] += opts.Damping*danglingSum*dangling[i] + (1-opts.Damping)*p[i]
+			change += math.Abs(x[i] - last[i])
+		}
+
+		if change < float64(n)*opts.Tolerance {
+			return scoreMap(verteces, x), nil
+		}
+	}
+
+	return scoreMap(verteces, x), fmt.Errorf("pagerank did not converge in %d iterations!", opts.MaxIterations)
+}
+
+// HITS hub and authority scores by power iteration, each summing to 1
+// A good hub links to good authorities, and a good authority is linked by good hubs
+func HITS(g GraphInterface, opts RankOptions) (hubs, authorities map[string]float64, err error) {
+	opts = opts.withDefaults()
+
+	verteces, index := indexVerteces(g)
+	n := len(verteces)
+	if n == 0 {
+		return map[string]float64{}, map[string]float64{}, nil
+	}
+
+	adj, err := rankAdjacency(g, verteces, index, opts.Weighted)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h := make([]float64, n)
+	for i := range h {
+		h[i] = 1 / float64(n)
+	}
+	a := make([]float64, n)
+
+	converged := false
+	for iter := 0; iter < opts.MaxIterations && !converged; iter++ {
+		last := h
+
+		// authorities collect from hubs, hubs collect from authorities
+		a = make([]float64, n)
+		for v, arcs := range adj {
+			for _, arc := range arcs {
+				a[arc.to] += last[v] * arc.weight
+			}
+		}
+		h = make([]float64, n)
+		for v, arcs := range adj {
+			for _, arc := range arcs {
+				h[v] += a[arc.to] * arc.weight
+			}
+		}
+
+		scaleToMax(h)
+		scaleToMax(a)
+
+		change := 0.0
+		for i := range h {
+			change += math.Abs(h[i] - last[i])
+		}
+		converged = change < float64(n)*opts.Tolerance
+	}
+
+	scaleToSum(h)
+	scaleToSum(a)
+	if !converged {
+		err = fmt.Errorf("hits did not converge in %d iterations!", opts.MaxIterations)
+	}
+
+	return scoreMap(verteces, h), scoreMap(verteces, a), err
+}
+
+// Build links of indexed verteces, weighted by edge weight or 1
+func rankAdjacency(g GraphInterface, verteces []VertexInterface, index map[string]int, weighted bool) (
+	[][]weightedArc, error) {
+	if weighted {
+		if err := checkNonNegativeWeights(g, "link analysis"); err != nil {
+			return nil, err
+		}
+	}
+
+	return weightedAdjacency(verteces, index, weighted), nil
+}
+
+// Normalize a distribution keyed by vertex name into indexed probabilities, nil means uniform
+func distribution(values map[string]float64, index map[string]int, name string) ([]float64, error) {
+	p := make([]float64, len(index))
+	if values == nil {
+		for i := range p {
+			p[i] = 1 / float64(len(p))
+		}
+		return p, nil
+	}
+
+	total := 0.0
+	for v, x := range values {
+		i, ok := index[v]
+		if !ok {
+			return nil, fmt.Errorf("%s vertex[name:%s] not exists!", name, v)
+		}
+		if x < 0 {
+			return nil, fmt.Errorf("%s value(%v) of vertex[name:%s] is negative!", name, x, v)
+		}
+		p[i] = x
+		total += x
+	}
+
+	if total == 0 {
+		return nil, fmt.Errorf("%s distribution sums to 0!", name)
+	}
+	for i := range p {
+		p[i] /= total
+	}
+
+	return p, nil
+}
+
+func scaleToMax(x []float64) {
+	max := 0.0
+	for _, v := range x {
+		max = math.Max(max, v)
+	}
+	if max > 0 {
+		for i := range x {
+			x[i] /= max
+		}
+	}
+}
+
+func scaleToSum(x []float64) {
+	sum := 0.0
+	for _, v := range x {
+		sum += v
+	}
+	if sum > 0 {
+		for i := range x {
+			x[i] /= sum
+		}
+	}
+}
+
+func scoreMap(verteces []VertexInterface, x []float64) map[string]float64 {
+	scores := make(map[string]float64, len(verteces))
+	for i, v := range verteces {
+		scores[v.Name()] = x[i]
+	}
+	return scores
+}
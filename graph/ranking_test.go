@@ -0,0 +1,124 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func Test4PageRank(t *testing.T) {
+	g := createLinkGraph4Test(t, [][2]string{{"a", "b"}, {"b", "c"}, {"c", "a"}})
+
+	scores, err := PageRank(g, DefaultRankOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkRank(t, "cycle pagerank", scores, map[string]float64{"a": 1.0 / 3, "b": 1.0 / 3, "c": 1.0 / 3})
+
+	// teleport only to a: x_a = 0.15 + 0.85 x_c, x_b = 0.85 x_a, x_c = 0.85 x_b
+	scores, err = PersonalizedPageRank(g, map[string]float64{"a": 2}, RankOptions{Tolerance: 1e-12, MaxIterations: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	xa := 0.15 / (1 - math.Pow(0.85, 3))
+	checkRank(t, "personalized pagerank", scores, map[string]float64{"a": xa, "b": 0.85 * xa, "c": 0.85 * 0.85 * xa})
+
+	if _, err := PersonalizedPageRank(g, map[string]float64{"x": 1}, RankOptions{}); err == nil {
+		t.Error("unknown teleport vertex should be rejected")
+	}
+
+	// damping 0 takes the default
+	defaults, _ := PersonalizedPageRank(g, map[string]float64{"a": 1}, RankOptions{Damping: 0.85})
+	scores, _ = PersonalizedPageRank(g, map[string]float64{"a": 1}, RankOptions{})
+	checkRank(t, "default damping", scores, defaults)
+	for _, damping := range []float64{-0.5, 1} {
+		if _, err := PageRank(g, RankOptions{Damping: damping}); err == nil {
+			t.Errorf("damping %v should be rejected", damping)
+		}
+	}
+}
+
+func Test4PageRank_DanglingAndWeighted(t *testing.T) {
+	// a is dangling
+	g := createLinkGraph4Test(t, [][2]string{{"b", "a"}, {"c", "a"}, {"d", "a"}})
+	scores, err := PageRank(g, RankOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := 0.0
+	for _, s := range scores {
+		sum += s
+	}
+	if math.Abs(sum-1) > 1e-6 || scores["a"] <= scores["b"] || math.Abs(scores["b"]-scores["d"]) > 1e-9 {
+		t.Errorf("unexpected scores %v", scores)
+	}
+
+	// heavier link from a to b
+	wg := createLinkGraph4Test(t, [][2]string{{"a", "b"}, {"a", "c"}, {"b", "a"}, {"c", "a"}})
+	wg.GetVertex("a").FindEdge(wg.GetVertex("b"), BackwardEdge).SetWeight(3)
+	scores, _ = PageRank(wg, RankOptions{})
+	if math.Abs(scores["b"]-scores["c"]) > 1e-9 {
+		t.Errorf("unweighted scores of b and c should be equal, %v", scores)
+	}
+	scores, _ = PageRank(wg, RankOptions{Weighted: true})
+	if scores["b"] <= scores["c"] {
+		t.Errorf("weighted score of b should be higher, %v", scores)
+	}
+
+	// links of weight 0 only make a dangling vertex
+	zg := createLinkGraph4Test(t, [][2]string{{"a", "b"}, {"b", "c"}})
+	zg.GetVertex("b").FindEdge(zg.GetVertex("c"), BackwardEdge).SetWeight(0)
+	scores, err = PageRank(zg, RankOptions{Weighted: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum = 0.0
+	for _, s := range scores {
+		if math.IsNaN(s) {
+			t.Fatalf("scores should not be NaN, %v", scores)
+		}
+		sum += s
+	}
+	if math.Abs(sum-1) > 1e-6 || scores["b"] <= scores["a"] {
+		t.Errorf("unexpected scores %v", scores)
+	}
+}
+
+func Test4HITS(t *testing.T) {
+	g := createLinkGraph4Test(t, [][2]string{{"a", "c"}, {"b", "c"}, {"b", "d"}})
+
+	hubs, authorities, err := HITS(g, RankOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hubs["b"] <= hubs["a"] || hubs["c"] != 0 || authorities["c"] <= authorities["d"] || authorities["a"] != 0 {
+		t.Errorf("unexpected hubs %v and authorities %v", hubs, authorities)
+	}
+	// the principal eigenvector of A^T A is (phi, 1) on (c, d)
+	phi := (1 + math.Sqrt(5)) / 2
+	checkRank(t, "authorities", authorities, map[string]float64{"c": phi / (phi + 1), "d": 1 / (phi + 1)})
+}
+
+func checkRank(t *testing.T, name string, scores, expected map[string]float64) {
+	for v, e := range expected {
+		if math.Abs(scores[v]-e) > 1e-5 {
+			t.Errorf("%s of %s is %v, expected %v", name, v, scores[v], e)
+		}
+	}
+}
+
+/// create directed link graph for test from a list of links
+func createLinkGraph4Test(t *testing.T, links [][2]string) *DirectedGraph {
+	g := NewDirectedGraph("LinkGraph")
+	for _, link := range links {
+		for _, name := range link {
+			if g.GetVertex(name) == nil {
+				g.InsertVertex(NewVertex(name, 0))
+			}
+		}
+		if g.InsertEdgeByName(link[0], link[1], NewEdge(1, BackwardEdge)) != nil {
+			t.Error("InsertEdge error")
+		}
+	}
+
+	return g
+}
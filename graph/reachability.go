@@ -0,0 +1,192 @@
+package graph
+
+import (
+	"fmt"
+)
+
+/**********************************************************************************/
+// reachability of DAG
+/**********************************************************************************/
+
+// Transitive closure of a DAG: a new DAG with an edge from every vertex to each vertex it reaches
+// Edges of g keep their weights, the added ones have weight 1
+func TransitiveClosure(g *DAG) (*DAG, error) {
+	idx, err := NewReachabilityIndex(g)
+	if err != nil {
+		return nil, err
+	}
+
+	closure, err := copyDAGVerteces(fmt.Sprintf("%s_closure", g.Name()), g)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range idx.verteces {
+		for j := i + 1; j < len(idx.verteces); j++ {
+			if !idx.reach[i].has(j) {
+				continue
+			}
+
+			var weight float32 = 1
+			if ei := v.FindEdge(idx.verteces[j], BackwardEdge); ei != nil {
+				weight = ei.Weight()
+			}
+			if err := closure.InsertEdgeByName(v.Name(), idx.verteces[j].Name(), NewEdge(weight, BackwardEdge)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return closure, nil
+}
+
+// Transitive reduction of a DAG: a new DAG with the fewest edges keeping the same reachability
+// An edge u -> v is dropped when v can be reached from another successor of u, the kept edges keep their weights
+func TransitiveReduction(g *DAG) (*DAG, error) {
+	idx, err := NewReachabilityIndex(g)
+	if err != nil {
+		return nil, err
+	}
+
+	reduction, err := copyDAGVerteces(fmt.Sprintf("%s_reduction", g.Name()), g)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range idx.verteces {
+		edges := v.EdgesBackward()
+		for _, ei := range edges {
+			to := idx.index[ei.To().Name()]
+
+			redundant := false
+			for _, other := range edges {
+				if w := idx.index[other.To().Name()]; w != to && idx.reach[w].has(to) {
+					redundant = true
+					break
+				}
+			}
+			if redundant {
+				continue
+			}
+
+			if err := reduction.InsertEdgeByName(v.Name(), ei.To().Name(), NewEdge(ei.Weight(), BackwardEdge)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return reduction, nil
+}
+
+// Create a DAG holding copies of all verteces of g, without edges
+func copyDAGVerteces(name string, g *DAG) (*DAG, error) {
+	dag := NewDAG(name)
+	for _, v := range g.Verteces() {
+		if err := dag.InsertVertex(v.Copy()); err != nil {
+			return nil, err
+		}
+	}
+	return dag, nil
+}
+
+// precomputed reachability of a DAG
+// Every vertex keeps the bitset of verteces it reaches, indexed by topological order,
+// so a query costs O(1) after O(V * E / 64) building time and O(V^2 / 64) words of memory
+// The index is not updated when the DAG changes
+type ReachabilityIndex struct {
+	// verteces in topological order
+	verteces []VertexInterface
+	index    map[string]int
+	reach    []bitset
+}
+
+// Build the reachability index of a DAG, a *CycleError is returned if it has a cycle
+func NewReachabilityIndex(g *DAG) (*ReachabilityIndex, error) {
+	verteces, err := TopoSort(g)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := &ReachabilityIndex{
+		verteces: verteces,
+		index:    make(map[string]int, len(verteces)),
+		reach:    make([]bitset, len(verteces)),
+	}
+	for i, v := range verteces {
+		idx.index[v.Name()] = i
+	}
+
+	// successors come later in topological order, so they are done first in reverse
+	for i := len(verteces) - 1; i >= 0; i-- {
+		idx.reach[i] = newBitset(len(verteces))
+		idx.reach[i].set(i)
+		for _, ei := range verteces[i].EdgesBackward() {
+			idx.reach[i].or(idx.reach[idx.index[ei.To().Name()]])
+		}
+	}
+
+	return idx, nil
+}
+
+// Determine if dst can be reached from src, a vertex always reaches itself
+// Verteces not in the indexed DAG reach nothing
+func (idx *ReachabilityIndex) Reachable(src, dst VertexInterface) bool {
+	if src == nil || dst == nil {
+		return false
+	}
+
+	i, ok := idx.index[src.Name()]
+	if !ok {
+		return false
+	}
+	j, ok := idx.index[dst.Name()]
+	if !ok {
+		return false
+	}
+
+	return idx.reach[i].has(j)
+}
+
+// Get the verteces reachable from src except itself, in topological order
+func (idx *ReachabilityIndex) Descendants(src VertexInterface) []VertexInterface {
+	if src == nil {
+		return nil
+	}
+
+	i, ok := idx.index[src.Name()]
+	if !ok {
+		return nil
+	}
+
+	var descendants []VertexInterface
+	for j := i + 1; j < len(idx.verteces); j++ {
+		if idx.reach[i].has(j) {
+			descendants = append(descendants, idx.verteces[j])
+		}
+	}
+	return descendants
+}
+
+/**********************************************************************************/
+// bitset
+/**********************************************************************************/
+
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) set(i int) {
+	b[i/64] |= 1 << uint(i%64)
+}
+
+func (b bitset) has(i int) bool {
+	return b[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (b bitset) or(other bitset) {
+	for i := range b {
+		b[i] |= other[i]
+	}
+}
@@ -0,0 +1,140 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func Test4TransitiveClosure(t *testing.T) {
+	g := createDependencyGraph4Test(t)
+
+	closure, err := TransitiveClosure(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDAGEdges(t, closure, "a->b:1 a->c:1 a->d:5 a->e:2 b->d:1 b->e:1 c->d:1 c->e:1 d->e:1")
+	if len(closure.Verteces()) != 6 || !closure.IsDag() {
+		t.Error("closure should be a DAG of 6 verteces")
+	}
+
+	// g itself is untouched
+	checkDAGEdges(t, g, "a->b:1 a->c:1 a->d:5 a->e:2 b->d:1 c->d:1 d->e:1")
+}
+
+func Test4TransitiveReduction(t *testing.T) {
+	reduction, err := TransitiveReduction(createDependencyGraph4Test(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDAGEdges(t, reduction, "a->b:1 a->c:1 b->d:1 c->d:1 d->e:1")
+
+	// reduction of the closure gives the same graph
+	closure, _ := TransitiveClosure(reduction)
+	again, _ := TransitiveReduction(closure)
+	checkDAGEdges(t, again, "a->b:1 a->c:1 b->d:1 c->d:1 d->e:1")
+}
+
+func Test4ReachabilityIndex(t *testing.T) {
+	g := createDependencyGraph4Test(t)
+	idx, err := NewReachabilityIndex(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		src, dst  string
+		reachable bool
+	}{
+		{"a", "e", true}, {"b", "e", true}, {"c", "c", true},
+		{"e", "a", false}, {"b", "c", false}, {"f", "a", false}, {"a", "f", false},
+	}
+	for _, c := range cases {
+		if idx.Reachable(g.GetVertex(c.src), g.GetVertex(c.dst)) != c.reachable {
+			t.Errorf("Reachable(%s, %s) should be %v", c.src, c.dst, c.reachable)
+		}
+	}
+	if idx.Reachable(g.GetVertex("a"), NewVertex("x", 0)) {
+		t.Error("vertex out of graph should not be reachable")
+	}
+	checkPath(t, idx.Descendants(g.GetVertex("c")), "d", "e")
+
+	// against bfs on random DAGs
+	rnd := rand.New(rand.NewSource(7))
+	for round := 0; round < 20; round++ {
+		dag := NewDAG("RandomDAG")
+		n := 70
+		for i := 0; i < n; i++ {
+			dag.InsertVertex(NewVertex(string(rune('0'+i)), 0))
+		}
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				if rnd.Float64() < 0.05 {
+					dag.InsertEdgeByName(string(rune('0'+i)), string(rune('0'+j)), NewEdge(1, BackwardEdge))
+				}
+			}
+		}
+
+		idx, err := NewReachabilityIndex(dag)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, src := range dag.Verteces() {
+			visited := make(map[string]bool)
+			BFSVertex(src, visited, func(VertexInterface) {})
+			for _, dst := range dag.Verteces() {
+				if idx.Reachable(src, dst) != visited[dst.Name()] {
+					t.Fatalf("Reachable(%s, %s) should be %v", src.Name(), dst.Name(), visited[dst.Name()])
+				}
+			}
+		}
+	}
+
+	cyclic := NewDAG("Cyclic")
+	cyclic.InsertVertex(NewVertex("a", 0))
+	cyclic.InsertVertex(NewVertex("b", 0))
+	cyclic.InsertEdgeByName("a", "b", NewEdge(1, BackwardEdge))
+	cyclic.InsertEdgeByName("b", "a", NewEdge(1, BackwardEdge))
+	if _, err := NewReachabilityIndex(cyclic); err == nil {
+		t.Error("cyclic graph should be rejected")
+	} else if _, ok := err.(*CycleError); !ok {
+		t.Errorf("error should be a *CycleError, got %v", err)
+	}
+}
+
+// Check the edges of a DAG, given as sorted 'from->to:weight' items
+func checkDAGEdges(t *testing.T, g *DAG, expected string) {
+	var edges []string
+	for _, ei := range edgeList(g) {
+		edges = append(edges, fmt.Sprintf("%s->%s:%v", ei.From().Name(), ei.To().Name(), ei.Weight()))
+	}
+	sort.Strings(edges)
+	if got := strings.Join(edges, " "); got != expected {
+		t.Errorf("edges are %s, expected %s", got, expected)
+	}
+}
+
+/// create build dependency DAG for test
+// a->b, a->c, b->d, c->d, d->e, plus the redundant a->d:5 and a->e:2, f isolated
+func createDependencyGraph4Test(t *testing.T) *DAG {
+	g := NewDAG("DependencyGraph")
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		if g.InsertVertex(NewVertex(name, 0)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	if g.InsertEdgeByName("a", "b", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("a", "c", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("b", "d", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("c", "d", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("d", "e", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("a", "d", NewEdge(5, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("a", "e", NewEdge(2, BackwardEdge)) != nil {
+		t.Error("InsertEdge error")
+	}
+
+	return g
+}
@@ -0,0 +1,221 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	simpleSt "graph/simplestructure"
+)
+
+/**********************************************************************************/
+// single-source shortest paths
+/**********************************************************************************/
+
+// shortest paths from a source vertex to every vertex of a graph
+type ShortestPaths struct {
+	Source VertexInterface
+	// distance from source, +Inf if the vertex is unreachable
+	Dist map[string]float64
+	// predecessor on the shortest path, source and unreachable verteces have none
+	Prev map[string]VertexInterface
+}
+
+func newShortestPaths(g GraphInterface, src VertexInterface) *ShortestPaths {
+	sp := &ShortestPaths{
+		Source: src,
+		Dist:   make(map[string]float64),
+		Prev:   make(map[string]VertexInterface),
+	}
+
+	for name := range g.Verteces() {
+		sp.Dist[name] = math.Inf(1)
+	}
+	sp.Dist[src.Name()] = 0
+
+	return sp
+}
+
+// Get the distance from source to dst, +Inf if dst is unreachable
+func (sp *ShortestPaths) DistTo(dst VertexInterface) float64 {
+	d, ok := sp.Dist[dst.Name()]
+	if !ok {
+		return math.Inf(1)
+	}
+	return d
+}
+
+// Reconstruct the shortest path from source to dst, nil if dst is unreachable
+func (sp *ShortestPaths) PathTo(dst VertexInterface) []VertexInterface {
+	if math.IsInf(sp.DistTo(dst), 1) {
+		return nil
+	}
+
+	var reversed []VertexInterface
+	for v := dst; v != nil; v = sp.Prev[v.Name()] {
+		reversed = append(reversed, v)
+		if v.Name() == sp.Source.Name() {
+			break
+		}
+	}
+
+	return reverseVerteces(reversed)
+}
+
+// weight of an edge walked from vertex 'from', +Inf means the edge is ignored
+type edgeWeightFunc func(from VertexInterface, ei EdgeInterface) float64
+
+func edgeWeight(from VertexInterface, ei EdgeInterface) float64 {
+	return float64(ei.Weight())
+}
+
+// Dijkstra single-source shortest paths
+// Edges are walked through EdgesBackward, so it works for both directed and undirected graph
+// Weights must be non-negative, use BellmanFord otherwise
+func Dijkstra(g GraphInterface, src VertexInterface) (*ShortestPaths, error) {
+	if err := checkVertex(g, src); err != nil {
+		return nil, err
+	}
+
+	if err := checkNonNegativeWeights(g, "dijkstra"); err != nil {
+		return nil, err
+	}
+
+	return dijkstra(g, src, edgeWeight), nil
+}
+
+// Check that no edge of the graph has a negative weight
+func checkNonNegativeWeights(g GraphInterface, algorithm string) error {
+	for _, v := range g.Verteces() {
+		for _, ei := range v.EdgesBackward() {
+			if ei.Weight() < 0 {
+				return fmt.Errorf("edge[%s -> %s] has negative weight(%v), %s requires non-negative weights!",
+					v.Name(), adjacentVertex(v, ei).Name(), ei.Weight(), algorithm)
+			}
+		}
+	}
+
+	return nil
+}
+
+func dijkstra(g GraphInterface, src VertexInterface, weight edgeWeightFunc) *ShortestPaths {
+	sp := newShortestPaths(g, src)
+	done := make(map[string]bool)
+
+	pq := simpleSt.NewSimplePriorityQueue()
+	pq.Push(src, 0)
+	for {
+		item := pq.Pop()
+		if item == nil {
+			break
+		}
+		v := item.(VertexInterface)
+		if done[v.Name()] {
+			continue
+		}
+		done[v.Name()] = true
+
+		for _, ei := range v.EdgesBackward() {
+			w := weight(v, ei)
+			if math.IsInf(w, 1) {
+				continue
+			}
+
+			adj := adjacentVertex(v, ei)
+			if done[adj.Name()] {
+				continue
+			}
+
+			if d := sp.Dist[v.Name()] + w; d < sp.Dist[adj.Name()] {
+				sp.Dist[adj.Name()] = d
+				sp.Prev[adj.Name()] = v
+				pq.Push(adj, d)
+			}
+		}
+	}
+
+	return sp
+}
+
+// BellmanFord single-source shortest paths, negative weights are allowed
+// If a negative cycle is reachable from source, a *NegativeCycleError carrying the cycle is returned
+func BellmanFord(g GraphInterface, src VertexInterface) (*ShortestPaths, error) {
+	if err := checkVertex(g, src); err != nil {
+		return nil, err
+	}
+
+	sp := newShortestPaths(g, src)
+	if err := bellmanFord(g, sp, edgeWeight); err != nil {
+		return nil, err
+	}
+
+	return sp, nil
+}
+
+// error for a negative cycle found by the shortest path algorithms
+type NegativeCycleError struct {
+	// verteces in walking order, the last vertex goes back to the first one
+	Cycle []VertexInterface
+}
+
+func (e *NegativeCycleError) Error() string {
+	return fmt.Sprintf("negative cycle found: %s", cycleString(e.Cycle))
+}
+
+// relax every edge |V| times on the initialized shortest paths
+// the last round only happens when some distance still decreases, which means a negative cycle
+func bellmanFord(g GraphInterface, sp *ShortestPaths, weight edgeWeightFunc) error {
+	verteces := g.Verteces()
+
+	for i := 0; i < len(verteces); i++ {
+		var last VertexInterface
+		for _, v := range verteces {
+			if math.IsInf(sp.Dist[v.Name()], 1) {
+				continue
+			}
+
+			for _, ei := range v.EdgesBackward() {
+				w := weight(v, ei)
+				if math.IsInf(w, 1) {
+					continue
+				}
+
+				adj := adjacentVertex(v, ei)
+				if d := sp.Dist[v.Name()] + w; d < sp.Dist[adj.Name()] {
+					sp.Dist[adj.Name()] = d
+					sp.Prev[adj.Name()] = v
+					last = adj
+				}
+			}
+		}
+
+		if last == nil {
+			return nil
+		}
+
+		if i == len(verteces)-1 {
+			return &NegativeCycleError{Cycle: negativeCycle(sp, last, len(verteces))}
+		}
+	}
+
+	return nil
+}
+
+// walk back from a vertex relaxed in the last bellman-ford round to extract the negative cycle
+func negativeCycle(sp *ShortestPaths, last VertexInterface, n int) []VertexInterface {
+	// after n steps back the walk must be inside the cycle
+	v := last
+	for i := 0; i < n; i++ {
+		v = sp.Prev[v.Name()]
+	}
+
+	var reversed []VertexInterface
+	for u := v; ; u = sp.Prev[u.Name()] {
+		reversed = append(reversed, u)
+		if len(reversed) > 1 && u.Name() == v.Name() {
+			reversed = reversed[:len(reversed)-1]
+			break
+		}
+	}
+
+	return reverseVerteces(reversed)
+}
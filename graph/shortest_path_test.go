@@ -0,0 +1,162 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func Test4Dijkstra_Directed(t *testing.T) {
+	g := createWeightedDirectedGraph4Test(t)
+
+	sp, err := Dijkstra(g, g.GetVertex("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]float64{"a": 0, "b": 3, "c": 1, "d": 4, "e": 7, "f": math.Inf(1)}
+	for name, d := range expected {
+		if sp.Dist[name] != d {
+			t.Errorf("dist of %s is %v, expected %v", name, sp.Dist[name], d)
+		}
+	}
+
+	checkPath(t, sp.PathTo(g.GetVertex("e")), "a", "c", "b", "d", "e")
+	if sp.PathTo(g.GetVertex("f")) != nil {
+		t.Error("vertex f should be unreachable")
+	}
+}
+
+func Test4Dijkstra_Undirected(t *testing.T) {
+	g := createWeightedUndirectedGraph4Test(t)
+
+	sp, err := Dijkstra(g, g.GetVertex("e"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sp.DistTo(g.GetVertex("a")) != 7 {
+		t.Errorf("dist of a is %v, expected 7", sp.DistTo(g.GetVertex("a")))
+	}
+	checkPath(t, sp.PathTo(g.GetVertex("a")), "e", "d", "b", "c", "a")
+}
+
+func Test4Dijkstra_Negative(t *testing.T) {
+	g := createWeightedDirectedGraph4Test(t)
+	g.InsertEdgeByName("e", "a", NewEdge(-1, BackwardEdge))
+
+	if _, err := Dijkstra(g, g.GetVertex("a")); err != nil {
+		t.Log(err)
+	} else {
+		t.Error("negative weight should be rejected.")
+	}
+
+	if _, err := Dijkstra(g, NewVertex("x", 0)); err != nil {
+		t.Log(err)
+	} else {
+		t.Error("source vertex should not exist.")
+	}
+}
+
+/// create weighted directed graph for test
+// a->b:4, a->c:1, c->b:2, b->d:1, c->d:5, d->e:3, and f is isolated
+func createWeightedDirectedGraph4Test(t *testing.T) *DirectedGraph {
+	g := NewDirectedGraph("WeightedDirectedGraph")
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		if g.InsertVertex(NewVertex(name, 0)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	if g.InsertEdgeByName("a", "b", NewEdge(4, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("a", "c", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("c", "b", NewEdge(2, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("b", "d", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("c", "d", NewEdge(5, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("d", "e", NewEdge(3, BackwardEdge)) != nil {
+		t.Error("InsertEdge error")
+	}
+
+	return g
+}
+
+/// create weighted undirected graph for test, same shape as the directed one
+func createWeightedUndirectedGraph4Test(t *testing.T) *UndirectedGraph {
+	g := NewUndirectedGraph("WeightedUndirectedGraph")
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		if g.InsertVertex(NewVertex(name, 0)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	if g.InsertEdgeByName("a", "b", NewEdge(4, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("a", "c", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("c", "b", NewEdge(2, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("b", "d", NewEdge(1, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("c", "d", NewEdge(5, UndirectedEdge)) != nil ||
+		g.InsertEdgeByName("d", "e", NewEdge(3, UndirectedEdge)) != nil {
+		t.Error("InsertEdge error")
+	}
+
+	return g
+}
+
+// check a vertex path against expected names
+func checkPath(t *testing.T, path []VertexInterface, names ...string) {
+	if len(path) != len(names) {
+		t.Errorf("path length is %d, expected %d", len(path), len(names))
+		return
+	}
+
+	for i, v := range path {
+		if v.Name() != names[i] {
+			t.Errorf("path[%d] is %s, expected %s", i, v.Name(), names[i])
+		}
+	}
+}
+
+func Test4BellmanFord(t *testing.T) {
+	g := createWeightedDirectedGraph4Test(t)
+	// a cheaper way to b through a refund edge
+	g.InsertEdgeByName("c", "e", NewEdge(2, BackwardEdge))
+	g.InsertEdgeByName("e", "b", NewEdge(-2, BackwardEdge))
+
+	sp, err := BellmanFord(g, g.GetVertex("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]float64{"a": 0, "b": 1, "c": 1, "d": 2, "e": 3, "f": math.Inf(1)}
+	for name, d := range expected {
+		if sp.Dist[name] != d {
+			t.Errorf("dist of %s is %v, expected %v", name, sp.Dist[name], d)
+		}
+	}
+	checkPath(t, sp.PathTo(g.GetVertex("d")), "a", "c", "e", "b", "d")
+}
+
+func Test4BellmanFord_NegativeCycle(t *testing.T) {
+	g := createWeightedDirectedGraph4Test(t)
+	// b -> d -> e -> b costs 1 + 3 - 5
+	g.InsertEdgeByName("e", "b", NewEdge(-5, BackwardEdge))
+
+	_, err := BellmanFord(g, g.GetVertex("a"))
+	cycleErr, ok := err.(*NegativeCycleError)
+	if !ok {
+		t.Fatalf("negative cycle should be reported, got %v", err)
+	}
+	t.Log(cycleErr)
+
+	if len(cycleErr.Cycle) != 3 {
+		t.Fatalf("cycle length is %d, expected 3", len(cycleErr.Cycle))
+	}
+	// rotate the cycle to start at b
+	for cycleErr.Cycle[0].Name() != "b" {
+		cycleErr.Cycle = append(cycleErr.Cycle[1:], cycleErr.Cycle[0])
+	}
+	checkPath(t, cycleErr.Cycle, "b", "d", "e")
+
+	// the cycle can not be reached from f
+	if _, err := BellmanFord(g, g.GetVertex("f")); err != nil {
+		t.Error(err)
+	}
+}
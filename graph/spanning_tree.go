@@ -0,0 +1,176 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+
+	simpleSt "graph/simplestructure"
+)
+
+/**********************************************************************************/
+// minimum spanning tree
+/**********************************************************************************/
+
+// Kruskal minimum spanning tree
+// A spanning forest is returned if the graph is disconnected
+// Return the chosen edges and their total weight
+func Kruskal(g GraphInterface) ([]EdgeInterface, float64, error) {
+	if err := checkUndirectedEdges(g, "spanning tree"); err != nil {
+		return nil, 0, err
+	}
+
+	_, index := indexVerteces(g)
+	edges := edgeList(g)
+	// stable sort keeps the name order between edges of equal weight
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].Weight() < edges[j].Weight()
+	})
+
+	var tree []EdgeInterface
+	total := 0.0
+	set := newDisjointSet(len(index))
+	for _, ei := range edges {
+		if set.union(index[ei.From().Name()], index[ei.To().Name()]) {
+			tree = append(tree, ei)
+			total += float64(ei.Weight())
+		}
+	}
+
+	return tree, total, nil
+}
+
+// Prim minimum spanning tree
+// A spanning forest is returned if the graph is disconnected, growing one tree from each component
+// Return the chosen edges and their total weight
+func Prim(g GraphInterface) ([]EdgeInterface, float64, error) {
+	if err := checkUndirectedEdges(g, "spanning tree"); err != nil {
+		return nil, 0, err
+	}
+
+	verteces, _ := indexVerteces(g)
+	inTree := make(map[string]bool)
+
+	var tree []EdgeInterface
+	total := 0.0
+	for _, root := range verteces {
+		if inTree[root.Name()] {
+			continue
+		}
+
+		// crossing edges keyed by weight
+		pq := simpleSt.NewSimplePriorityQueue()
+		grow := func(v VertexInterface) {
+			inTree[v.Name()] = true
+			for _, ei := range v.EdgesBackward() {
+				if adj := adjacentVertex(v, ei); !inTree[adj.Name()] {
+					pq.Push(crossingEdge{edge: ei, to: adj}, float64(ei.Weight()))
+				}
+			}
+		}
+
+		grow(root)
+		for {
+			item := pq.Pop()
+			if item == nil {
+				break
+			}
+			crossing := item.(crossingEdge)
+			if inTree[crossing.to.Name()] {
+				continue
+			}
+
+			tree = append(tree, crossing.edge)
+			total += float64(crossing.edge.Weight())
+			grow(crossing.to)
+		}
+	}
+
+	return tree, total, nil
+}
+
+// edge leaving the growing tree of prim
+type crossingEdge struct {
+	edge EdgeInterface
+	to   VertexInterface
+}
+
+// Create a new undirected graph holding copies of all verteces and the given spanning edges
+func NewSpanningGraph(name string, g GraphInterface, edges []EdgeInterface) (*UndirectedGraph, error) {
+	newG := NewUndirectedGraph(name)
+	for _, v := range g.Verteces() {
+		if err := newG.InsertVertex(v.Copy()); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, ei := range edges {
+		err := newG.InsertEdgeByName(ei.From().Name(), ei.To().Name(), NewEdge(ei.Weight(), UndirectedEdge))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return newG, nil
+}
+
+// Check that every edge of the graph is undirected
+func checkUndirectedEdges(g GraphInterface, algorithm string) error {
+	for _, v := range g.Verteces() {
+		for _, ei := range v.Edges() {
+			if ei.Type() != UndirectedEdge {
+				return fmt.Errorf("Edge type(%s) wrong! %s requires undirected edges.", ei.Type(), algorithm)
+			}
+		}
+	}
+
+	return nil
+}
+
+/**********************************************************************************/
+// disjoint set
+/**********************************************************************************/
+
+// union-find over indexes 0..n-1
+type disjointSet struct {
+	parent []int
+	rank   []int
+}
+
+func newDisjointSet(n int) *disjointSet {
+	set := &disjointSet{
+		parent: make([]int, n),
+		rank:   make([]int, n),
+	}
+	for i := range set.parent {
+		set.parent[i] = i
+	}
+	return set
+}
+
+func (set *disjointSet) find(x int) int {
+	for set.parent[x] != x {
+		set.parent[x] = set.parent[set.parent[x]]
+		x = set.parent[x]
+	}
+	return x
+}
+
+// merge the sets of x and y, false if they are already in the same set
+func (set *disjointSet) union(x, y int) bool {
+	rx, ry := set.find(x), set.find(y)
+	if rx == ry {
+		return false
+	}
+
+	switch {
+	case set.rank[rx] < set.rank[ry]:
+		set.parent[rx] = ry
+	case set.rank[rx] > set.rank[ry]:
+		set.parent[ry] = rx
+	default:
+		set.parent[ry] = rx
+		set.rank[rx]++
+	}
+
+	return true
+}
@@ -0,0 +1,52 @@
+package graph
+
+import (
+	"testing"
+)
+
+func Test4SpanningTree(t *testing.T) {
+	g := createWeightedUndirectedGraph4Test(t)
+	// second component
+	g.InsertVertex(NewVertex("g", 0))
+	g.InsertEdgeByName("f", "g", NewEdge(6, UndirectedEdge))
+
+	for name, mst := range map[string]func(GraphInterface) ([]EdgeInterface, float64, error){
+		"kruskal": Kruskal,
+		"prim":    Prim,
+	} {
+		edges, total, err := mst(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// a-c:1, c-b:2, b-d:1, d-e:3 and f-g:6
+		if len(edges) != 5 || total != 13 {
+			t.Errorf("%s got %d edges with weight %v, expected 5 edges with weight 13", name, len(edges), total)
+		}
+
+		forest, err := NewSpanningGraph(name, g, edges)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(forest.Verteces()) != len(g.Verteces()) {
+			t.Errorf("%s forest has %d verteces", name, len(forest.Verteces()))
+		}
+		if forest.GetVertex("c").Indegree() != 2 || forest.GetVertex("a").FindEdge(forest.GetVertex("b"), UndirectedEdge) != nil {
+			t.Errorf("%s forest has wrong edges", name)
+		}
+	}
+}
+
+func Test4SpanningTree_Directed(t *testing.T) {
+	g := createWeightedDirectedGraph4Test(t)
+
+	if _, _, err := Kruskal(g); err != nil {
+		t.Log(err)
+	} else {
+		t.Error("directed graph should be rejected.")
+	}
+
+	if _, _, err := Prim(g); err == nil {
+		t.Error("directed graph should be rejected.")
+	}
+}
@@ -0,0 +1,157 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+/**********************************************************************************/
+// strongly connected components
+/**********************************************************************************/
+
+// Tarjan strongly connected components
+// Components are in topological order: edges between components only point to later ones
+// Undirected edges connect both ways, so components of an undirected graph are its connected components
+func StronglyConnectedComponents(g GraphInterface) [][]VertexInterface {
+	verteces, index := indexVerteces(g)
+
+	var components [][]VertexInterface
+	for _, c := range tarjan(adjacencyList(verteces, index)) {
+		component := make([]VertexInterface, 0, len(c))
+		for _, i := range c {
+			component = append(component, verteces[i])
+		}
+		components = append(components, component)
+	}
+
+	return components
+}
+
+// Condense each strongly connected component into one vertex
+// The new DAG names its verteces 'scc<i>' by the topological order of components,
+// and each vertex holds the []VertexInterface of its members as data
+// Edges between two components keep the cheapest weight among the original edges
+// Return the DAG and the map from original vertex name to component vertex name
+func Condense(g GraphInterface) (*DAG, map[string]string, error) {
+	components := StronglyConnectedComponents(g)
+
+	dag := NewDAG(fmt.Sprintf("%s_condensation", g.Name()))
+	componentOf := make(map[string]int)
+	names := make(map[string]string)
+	for i, component := range components {
+		name := fmt.Sprintf("scc%d", i)
+		if err := dag.InsertVertex(NewVertex(name, component)); err != nil {
+			return nil, nil, err
+		}
+		for _, v := range component {
+			componentOf[v.Name()] = i
+			names[v.Name()] = name
+		}
+	}
+
+	// cheapest weight between each pair of components
+	weights := make(map[[2]int]float32)
+	var pairs [][2]int
+	for _, ei := range edgeList(g) {
+		// an undirected edge never leaves its component
+		pair := [2]int{componentOf[ei.From().Name()], componentOf[ei.To().Name()]}
+		if pair[0] == pair[1] {
+			continue
+		}
+
+		if w, ok := weights[pair]; !ok {
+			pairs = append(pairs, pair)
+			weights[pair] = ei.Weight()
+		} else if ei.Weight() < w {
+			weights[pair] = ei.Weight()
+		}
+	}
+
+	for _, pair := range pairs {
+		err := dag.InsertEdgeByName(fmt.Sprintf("scc%d", pair[0]), fmt.Sprintf("scc%d", pair[1]),
+			NewEdge(weights[pair], BackwardEdge))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return dag, names, nil
+}
+
+// Build the successor lists of indexed verteces
+func adjacencyList(verteces []VertexInterface, index map[string]int) [][]int {
+	adj := make([][]int, len(verteces))
+	for i, v := range verteces {
+		for _, ei := range v.EdgesBackward() {
+			adj[i] = append(adj[i], index[adjacentVertex(v, ei).Name()])
+		}
+	}
+	return adj
+}
+
+// Tarjan algorithm over successor lists
+// Return components in topological order, each listing its members by increasing index
+func tarjan(adj [][]int) [][]int {
+	n := len(adj)
+	order := make([]int, n)
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	for i := range order {
+		order[i] = -1
+	}
+
+	var stack []int
+	var components [][]int
+	counter := 0
+
+	var visit func(v int)
+	visit = func(v int) {
+		order[v] = counter
+		low[v] = counter
+		counter++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, w := range adj[v] {
+			if order[w] == -1 {
+				visit(w)
+				if low[w] < low[v] {
+					low[v] = low[w]
+				}
+			} else if onStack[w] && order[w] < low[v] {
+				low[v] = order[w]
+			}
+		}
+
+		// v is the root of a component
+		if low[v] == order[v] {
+			var component []int
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == v {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	for v := 0; v < n; v++ {
+		if order[v] == -1 {
+			visit(v)
+		}
+	}
+
+	// tarjan finds sink components first
+	for i, j := 0, len(components)-1; i < j; i, j = i+1, j-1 {
+		components[i], components[j] = components[j], components[i]
+	}
+	for _, component := range components {
+		sort.Ints(component)
+	}
+
+	return components
+}
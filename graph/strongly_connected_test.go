@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test4StronglyConnectedComponents(t *testing.T) {
+	g := createCyclicGraph4Test(t)
+
+	components := StronglyConnectedComponents(g)
+	var got []string
+	for _, c := range components {
+		var names []string
+		for _, v := range c {
+			names = append(names, v.Name())
+		}
+		got = append(got, strings.Join(names, ","))
+	}
+	t.Log(got)
+
+	expected := []string{"a,b,c", "d,e", "f"}
+	if strings.Join(got, " ") != strings.Join(expected, " ") {
+		t.Errorf("components are %v, expected %v", got, expected)
+	}
+}
+
+func Test4Condense(t *testing.T) {
+	g := createCyclicGraph4Test(t)
+
+	dag, names, err := Condense(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !dag.IsDag() || len(dag.Verteces()) != 3 {
+		t.Fatal("condensation should be a DAG of 3 verteces")
+	}
+
+	if names["a"] != names["c"] || names["d"] != names["e"] || names["a"] == names["f"] {
+		t.Errorf("wrong component names %v", names)
+	}
+
+	// c->d:2 and b->e:1 collapse into one edge
+	abc, de := dag.GetVertex(names["a"]), dag.GetVertex(names["d"])
+	ei := abc.FindEdge(de, BackwardEdge)
+	if ei == nil || ei.Weight() != 1 {
+		t.Error("components should be linked by the cheapest edge")
+	}
+	if len(abc.Data().([]VertexInterface)) != 3 {
+		t.Error("component vertex should hold its members")
+	}
+
+	sorted, err := TopoSort(dag)
+	if err != nil || len(sorted) != 3 {
+		t.Error("condensation should be sorted")
+	}
+}
+
+/// create cyclic directed graph for test
+// a->b->c->a, d->e->d, c->d:2, b->e:1, e->f
+func createCyclicGraph4Test(t *testing.T) *DirectedGraph {
+	g := NewDirectedGraph("CyclicGraph")
+	for _, name := range []string{"f", "e", "d", "c", "b", "a"} {
+		if g.InsertVertex(NewVertex(name, 0)) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	if g.InsertEdgeByName("a", "b", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("b", "c", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("c", "a", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("d", "e", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("e", "d", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("c", "d", NewEdge(2, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("b", "e", NewEdge(1, BackwardEdge)) != nil ||
+		g.InsertEdgeByName("e", "f", NewEdge(1, BackwardEdge)) != nil {
+		t.Error("InsertEdge error")
+	}
+
+	return g
+}
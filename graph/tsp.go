@@ -0,0 +1,451 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+/**********************************************************************************/
+// traveling salesman
+/**********************************************************************************/
+
+const tspEpsilon = 1e-9
+
+// the largest graph held-karp accepts, it takes O(2^n * n) memory
+const heldKarpMaxVerteces = 16
+
+// Nearest neighbor tour: from start, always go to the closest vertex not visited yet
+// Tours of the traveling salesman functions work on a connected undirected graph with non-negative weights,
+// the distance between two verteces is the length of the shortest path between them,
+// so consecutive verteces of a tour may be joined through other verteces of the graph
+// A tour is a Path beginning and ending at start and visiting every other vertex once
+func NearestNeighborTour(g GraphInterface, start VertexInterface) (Path, error) {
+	tsp, err := newTSPInstance(g, start, "nearest neighbor tour")
+	if err != nil {
+		return Path{}, err
+	}
+
+	n := len(tsp.verteces)
+	visited := make([]bool, n)
+	tour := []int{tsp.index[start.Name()]}
+	visited[tour[0]] = true
+	for len(tour) < n {
+		last, next := tour[len(tour)-1], -1
+		for v := 0; v < n; v++ {
+			if !visited[v] && (next < 0 || tsp.dist[last][v] < tsp.dist[last][next]) {
+				next = v
+			}
+		}
+		visited[next] = true
+		tour = append(tour, next)
+	}
+
+	return tsp.path(append(tour, tour[0])), nil
+}
+
+// Christofides tour, at most 1.5 times as long as the optimal one
+// Minimum spanning tree plus a minimum weight perfect matching of its odd verteces gives an eulerian multigraph,
+// and the tour is its eulerian circuit skipping verteces already visited
+func ChristofidesTour(g GraphInterface, start VertexInterface) (Path, error) {
+	tsp, err := newTSPInstance(g, start, "christofides tour")
+	if err != nil {
+		return Path{}, err
+	}
+
+	n := len(tsp.verteces)
+	arcs := make([][]eulerArc, n)
+	m := 0
+	join := func(u, v int) {
+		arcs[u] = append(arcs[u], eulerArc{edge: m, to: v})
+		arcs[v] = append(arcs[v], eulerArc{edge: m, to: u})
+		m++
+	}
+
+	// prim over the complete graph of distances
+	inTree := make([]bool, n)
+	parent := make([]int, n)
+	best := make([]float64, n)
+	for v := range best {
+		best[v] = math.Inf(1)
+		parent[v] = -1
+	}
+	best[0] = 0
+	degree := make([]int, n)
+	for k := 0; k < n; k++ {
+		u := -1
+		for v := 0; v < n; v++ {
+			if !inTree[v] && (u < 0 || best[v] < best[u]) {
+				u = v
+			}
+		}
+		inTree[u] = true
+		if parent[u] >= 0 {
+			join(parent[u], u)
+			degree[parent[u]]++
+			degree[u]++
+		}
+		for v := 0; v < n; v++ {
+			if !inTree[v] && tsp.dist[u][v] < best[v] {
+				best[v] = tsp.dist[u][v]
+				parent[v] = u
+			}
+		}
+	}
+
+	// minimum weight perfect matching of odd verteces, as a maximum weight one of the largest cardinality
+	var odd []int
+	for v, d := range degree {
+		if d%2 == 1 {
+			odd = append(odd, v)
+		}
+	}
+	max := 0.0
+	for _, u := range odd {
+		for _, v := range odd {
+			max = math.Max(max, tsp.dist[u][v])
+		}
+	}
+	var pairs []weightedPair
+	for i := range odd {
+		for j := i + 1; j < len(odd); j++ {
+			pairs = append(pairs, weightedPair{i: i, j: j, weight: max + 1 - tsp.dist[odd[i]][odd[j]]})
+		}
+	}
+	mate := newWeightedMatching(len(odd), pairs).solve(true)
+	for i, j := range mate {
+		if j > i {
+			join(odd[i], odd[j])
+		}
+	}
+
+	_, circuit := hierholzerWalk(arcs, m, tsp.index[start.Name()])
+	return tsp.path(shortcut(circuit, n)), nil
+}
+
+// Skip verteces of a closed walk visited before, and close the tour at the first vertex
+func shortcut(walk []int, n int) []int {
+	visited := make([]bool, n)
+	tour := make([]int, 0, n+1)
+	for _, v := range walk {
+		if !visited[v] {
+			visited[v] = true
+			tour = append(tour, v)
+		}
+	}
+	return append(tour, tour[0])
+}
+
+// 2-opt improvement of a tour: reverse a part of the tour whenever it makes the tour shorter
+// Stop at a tour no single reversal improves, the tour keeps its first vertex
+func TwoOpt(g GraphInterface, tour Path) (Path, error) {
+	tsp, t, err := newTSPTour(g, tour, "2-opt")
+	if err != nil {
+		return Path{}, err
+	}
+
+	n := len(tsp.verteces)
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < n-2; i++ {
+			for j := i + 2; j < n; j++ {
+				// replace a-b and c-d by a-c and b-d
+				a, b, c, d := t[i], t[i+1], t[j], t[j+1]
+				if tsp.dist[a][c]+tsp.dist[b][d] < tsp.dist[a][b]+tsp.dist[c][d]-tspEpsilon {
+					reverseInts(t[i+1 : j+1])
+					improved = true
+				}
+			}
+		}
+	}
+
+	return tsp.path(t), nil
+}
+
+// Or-opt improvement of a tour: move a run of up to 3 verteces, maybe reversed, to another place of the tour
+// whenever it makes the tour shorter. Stop at a tour no single move improves, the tour keeps its first vertex
+func OrOpt(g GraphInterface, tour Path) (Path, error) {
+	tsp, t, err := newTSPTour(g, tour, "or-opt")
+	if err != nil {
+		return Path{}, err
+	}
+
+	n := len(tsp.verteces)
+	for improved := true; improved; {
+		improved = false
+		for length := 1; length <= 3 && !improved; length++ {
+			// the run t[i..k] never holds the first vertex
+			for i := 1; i+length <= n && !improved; i++ {
+				k := i + length - 1
+				prev, next := t[i-1], t[k+1]
+				removed := tsp.dist[prev][t[i]] + tsp.dist[t[k]][next] - tsp.dist[prev][next]
+
+				for j := 0; j < n && !improved; j++ {
+					if j >= i-1 && j <= k {
+						continue
+					}
+					// insert between t[j] and t[j+1], in order or reversed
+					a, b := t[j], t[j+1]
+					forward := tsp.dist[a][t[i]] + tsp.dist[t[k]][b] - tsp.dist[a][b]
+					backward := tsp.dist[a][t[k]] + tsp.dist[t[i]][b] - tsp.dist[a][b]
+					if math.Min(forward, backward) < removed-tspEpsilon {
+						t = moveRun(t, i, k, j, backward < forward)
+						improved = true
+					}
+				}
+			}
+		}
+	}
+
+	return tsp.path(t), nil
+}
+
+// Move the run t[i..k] behind t[j], j is out of [i-1, k]
+func moveRun(t []int, i, k, j int, reversed bool) []int {
+	run := append([]int{}, t[i:k+1]...)
+	if reversed {
+		reverseInts(run)
+	}
+
+	moved := make([]int, 0, len(t))
+	for p, v := range t {
+		if p >= i && p <= k {
+			continue
+		}
+		moved = append(moved, v)
+		if p == j {
+			moved = append(moved, run...)
+		}
+	}
+	return moved
+}
+
+// Held-Karp dynamic programming for the optimal tour, for graphs of up to 16 verteces
+func HeldKarp(g GraphInterface, start VertexInterface) (Path, error) {
+	tsp, err := newTSPInstance(g, start, "held-karp")
+	if err != nil {
+		return Path{}, err
+	}
+
+	n := len(tsp.verteces)
+	if n > heldKarpMaxVerteces {
+		return Path{}, fmt.Errorf("graph[name:%s] has %d verteces, held-karp supports at most %d!",
+			g.Name(), n, heldKarpMaxVerteces)
+	}
+
+	s := tsp.index[start.Name()]
+	var others []int
+	for v := 0; v < n; v++ {
+		if v != s {
+			others = append(others, v)
+		}
+	}
+	m := len(others)
+	if m == 0 {
+		return tsp.path([]int{s, s}), nil
+	}
+
+	// cost[set][j]: shortest path from start through the set of others, ending at others[j] in the set
+	full := 1 << uint(m)
+	cost := make([][]float64, full)
+	prev := make([][]int, full)
+	for set := 1; set < full; set++ {
+		cost[set] = make([]float64, m)
+		prev[set] = make([]int, m)
+		for j := 0; j < m; j++ {
+			cost[set][j] = math.Inf(1)
+			prev[set][j] = -1
+			if set&(1<<uint(j)) == 0 {
+				continue
+			}
+
+			rest := set &^ (1 << uint(j))
+			if rest == 0 {
+				cost[set][j] = tsp.dist[s][others[j]]
+				continue
+			}
+			for i := 0; i < m; i++ {
+				if rest&(1<<uint(i)) == 0 {
+					continue
+				}
+				if c := cost[rest][i] + tsp.dist[others[i]][others[j]]; c < cost[set][j] {
+					cost[set][j] = c
+					prev[set][j] = i
+				}
+			}
+		}
+	}
+
+	last := 0
+	for j := 1; j < m; j++ {
+		if cost[full-1][j]+tsp.dist[others[j]][s] < cost[full-1][last]+tsp.dist[others[last]][s] {
+			last = j
+		}
+	}
+
+	reversed := []int{s}
+	for set, j := full-1, last; j >= 0; {
+		reversed = append(reversed, others[j])
+		set, j = set&^(1<<uint(j)), prev[set][j]
+	}
+	reversed = append(reversed, s)
+	reverseInts(reversed)
+
+	return tsp.path(reversed), nil
+}
+
+// Backtracking search for a path visiting every vertex once, following edges of the graph
+// src and dst fix the ends of the path, nil for any vertex. Return nil if there is no such path
+// It takes exponential time in the worst case
+func HamiltonianPath(g GraphInterface, src, dst VertexInterface) ([]VertexInterface, error) {
+	for _, v := range []VertexInterface{src, dst} {
+		if v != nil {
+			if err := checkVertex(g, v); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	verteces, index := indexVerteces(g)
+	n := len(verteces)
+	adj := make([][]int, n)
+	for i, v := range verteces {
+		for _, ei := range v.EdgesBackward() {
+			adj[i] = append(adj[i], index[adjacentVertex(v, ei).Name()])
+		}
+		sort.Ints(adj[i])
+	}
+
+	end := -1
+	if dst != nil {
+		end = index[dst.Name()]
+	}
+
+	visited := make([]bool, n)
+	path := make([]int, 0, n)
+	var extend func(v int) bool
+	extend = func(v int) bool {
+		visited[v] = true
+		path = append(path, v)
+		if len(path) == n {
+			if end < 0 || v == end {
+				return true
+			}
+		} else if v != end {
+			for _, w := range adj[v] {
+				if !visited[w] && extend(w) {
+					return true
+				}
+			}
+		}
+		visited[v] = false
+		path = path[:len(path)-1]
+		return false
+	}
+
+	for i := range verteces {
+		if src != nil && i != index[src.Name()] {
+			continue
+		}
+		if n > 0 && extend(i) {
+			hamiltonian := make([]VertexInterface, 0, n)
+			for _, v := range path {
+				hamiltonian = append(hamiltonian, verteces[v])
+			}
+			return hamiltonian, nil
+		}
+	}
+
+	return nil, nil
+}
+
+/**********************************************************************************/
+// distances for traveling salesman
+/**********************************************************************************/
+
+// shortest path distances between every pair of verteces, indexed by vertex names
+type tspInstance struct {
+	verteces []VertexInterface
+	index    map[string]int
+	dist     [][]float64
+}
+
+func newTSPInstance(g GraphInterface, start VertexInterface, algorithm string) (*tspInstance, error) {
+	if err := checkVertex(g, start); err != nil {
+		return nil, err
+	}
+	if err := checkUndirectedEdges(g, algorithm); err != nil {
+		return nil, err
+	}
+	if err := checkNonNegativeWeights(g, algorithm); err != nil {
+		return nil, err
+	}
+
+	ap, err := FloydWarshall(g)
+	if err != nil {
+		return nil, err
+	}
+
+	verteces, index := indexVerteces(g)
+	tsp := &tspInstance{
+		verteces: verteces,
+		index:    index,
+		dist:     make([][]float64, len(verteces)),
+	}
+	for i, u := range verteces {
+		tsp.dist[i] = make([]float64, len(verteces))
+		for j, v := range verteces {
+			tsp.dist[i][j] = ap.Dist(u.Name(), v.Name())
+			if math.IsInf(tsp.dist[i][j], 1) {
+				return nil, fmt.Errorf("vertex[name:%s] can not reach vertex[name:%s], %s requires a connected graph!",
+					u.Name(), v.Name(), algorithm)
+			}
+		}
+	}
+
+	return tsp, nil
+}
+
+// Check a tour of the graph and get it as indexes
+func newTSPTour(g GraphInterface, tour Path, algorithm string) (*tspInstance, []int, error) {
+	if len(tour.Verteces) == 0 {
+		return nil, nil, fmt.Errorf("Input is null, please do check!")
+	}
+
+	tsp, err := newTSPInstance(g, tour.Verteces[0], algorithm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := len(tsp.verteces)
+	t := make([]int, 0, n+1)
+	visited := make([]bool, n)
+	for _, v := range tour.Verteces {
+		i, ok := tsp.index[v.Name()]
+		if !ok {
+			return nil, nil, fmt.Errorf("vertex[name:%s] not exists in graph[name:%s]!", v.Name(), g.Name())
+		}
+		if visited[i] && len(t) < n {
+			return nil, nil, fmt.Errorf("vertex[name:%s] is visited twice, not a tour!", v.Name())
+		}
+		visited[i] = true
+		t = append(t, i)
+	}
+	if len(t) != n+1 || t[0] != t[n] {
+		return nil, nil, fmt.Errorf("tour %s does not visit every vertex once and go back!", cycleString(tour.Verteces))
+	}
+
+	return tsp, t, nil
+}
+
+// Get a tour of indexes as a path with its length
+func (tsp *tspInstance) path(tour []int) Path {
+	p := Path{Verteces: make([]VertexInterface, 0, len(tour))}
+	for k, v := range tour {
+		p.Verteces = append(p.Verteces, tsp.verteces[v])
+		if k > 0 {
+			p.Cost += tsp.dist[tour[k-1]][v]
+		}
+	}
+	return p
+}
@@ -0,0 +1,191 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func Test4TSP(t *testing.T) {
+	g := createCityGraph4Test(t, [][2]float64{{0, 0}, {0, 3}, {4, 3}, {4, 0}, {2, 5}, {2, -1}})
+	start := g.GetVertex("c0")
+
+	// c0 c5 c3 c2 c4 c1 c0
+	optimal := 2*math.Sqrt(5) + 3 + 2*math.Sqrt(8) + 3
+
+	exact, err := HeldKarp(g, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTour(t, g, exact, "c0")
+	if math.Abs(exact.Cost-optimal) > 1e-4 {
+		t.Errorf("held-karp tour %v costs %v, expected %v", exact.Names(), exact.Cost, optimal)
+	}
+
+	for name, heuristic := range map[string]func(GraphInterface, VertexInterface) (Path, error){
+		"nearest neighbor": NearestNeighborTour,
+		"christofides":     ChristofidesTour,
+	} {
+		tour, err := heuristic(g, start)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkTour(t, g, tour, "c0")
+		if tour.Cost < optimal-1e-4 || (name == "christofides" && tour.Cost > 1.5*optimal) {
+			t.Errorf("%s tour %v costs %v, optimal %v", name, tour.Names(), tour.Cost, optimal)
+		}
+
+		// 2-opt reaches the optimal tour of points on a convex hull
+		improved, err := TwoOpt(g, tour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkTour(t, g, improved, "c0")
+		if math.Abs(improved.Cost-optimal) > 1e-4 {
+			t.Errorf("2-opt tour %v costs %v, expected %v", improved.Names(), improved.Cost, optimal)
+		}
+	}
+
+	if _, err := TwoOpt(g, Path{Verteces: []VertexInterface{start, g.GetVertex("c1"), start}}); err == nil {
+		t.Error("tour missing verteces should be rejected")
+	}
+}
+
+func Test4TSP_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(11))
+	for round := 0; round < 20; round++ {
+		points := make([][2]float64, 4+round%6)
+		for i := range points {
+			points[i] = [2]float64{float64(rnd.Intn(20)), float64(rnd.Intn(20))}
+		}
+		g := createCityGraph4Test(t, points)
+		start := g.GetVertex("c0")
+
+		exact, err := HeldKarp(g, start)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkTour(t, g, exact, "c0")
+
+		nearest, _ := NearestNeighborTour(g, start)
+		christofides, _ := ChristofidesTour(g, start)
+		twoOpt, _ := TwoOpt(g, nearest)
+		orOpt, _ := OrOpt(g, nearest)
+		both, _ := OrOpt(g, twoOpt)
+		for name, tour := range map[string]Path{
+			"nearest neighbor": nearest, "christofides": christofides, "2-opt": twoOpt, "or-opt": orOpt, "2-opt + or-opt": both,
+		} {
+			checkTour(t, g, tour, "c0")
+			if tour.Cost < exact.Cost-1e-4 {
+				t.Errorf("%s tour costs %v, less than optimal %v", name, tour.Cost, exact.Cost)
+			}
+		}
+		if christofides.Cost > 1.5*exact.Cost+1e-4 {
+			t.Errorf("christofides tour costs %v, more than 1.5 times of optimal %v", christofides.Cost, exact.Cost)
+		}
+		if twoOpt.Cost > nearest.Cost+1e-9 || orOpt.Cost > nearest.Cost+1e-9 || both.Cost > twoOpt.Cost+1e-9 {
+			t.Error("improvement should not make tour longer")
+		}
+	}
+}
+
+func Test4TSP_Sparse(t *testing.T) {
+	// a path graph: the tour goes there and back
+	g := createPathGraph4Test(t)
+	tour, err := ChristofidesTour(g, g.GetVertex("c"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTour(t, g, tour, "c")
+	if tour.Cost != 8 {
+		t.Errorf("tour %v costs %v, expected 8", tour.Names(), tour.Cost)
+	}
+
+	g.InsertVertex(NewVertex("x", 0))
+	if _, err := HeldKarp(g, g.GetVertex("a")); err == nil {
+		t.Error("disconnected graph should be rejected")
+	}
+}
+
+func Test4HamiltonianPath(t *testing.T) {
+	g := createPathGraph4Test(t)
+	path, err := HamiltonianPath(g, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPath(t, path, "a", "b", "c", "d", "e")
+
+	if path, _ := HamiltonianPath(g, g.GetVertex("b"), nil); path != nil {
+		t.Error("path graph has no hamiltonian path from b")
+	}
+	path, _ = HamiltonianPath(g, nil, g.GetVertex("a"))
+	checkPath(t, path, "e", "d", "c", "b", "a")
+
+	// directed: a->b->c->a, c->d; every hamiltonian path ends at d
+	dg := createLinkGraph4Test(t, splitLinks("a->b b->c c->a c->d"))
+	path, _ = HamiltonianPath(dg, nil, nil)
+	checkPath(t, path, "a", "b", "c", "d")
+	if path, _ := HamiltonianPath(dg, nil, dg.GetVertex("c")); path != nil {
+		t.Error("no hamiltonian path should end at c")
+	}
+
+	// petersen graph has a hamiltonian path but no hamiltonian cycle
+	petersen := createUndirectedLinkGraph4Test(t,
+		"0-1 1-2 2-3 3-4 4-0 0-5 1-6 2-7 3-8 4-9 5-7 7-9 9-6 6-8 8-5")
+	path, _ = HamiltonianPath(petersen, nil, nil)
+	if len(path) != 10 {
+		t.Fatalf("hamiltonian path of petersen graph not found, %v", path)
+	}
+	for i := 1; i < len(path); i++ {
+		if path[i-1].FindEdge(path[i], UndirectedEdge) == nil {
+			t.Errorf("%s and %s are not adjacent", path[i-1].Name(), path[i].Name())
+		}
+	}
+	for _, v := range petersen.Verteces() {
+		for _, ei := range v.Edges() {
+			if adj := adjacentVertex(v, ei); adj.Name() > v.Name() {
+				if cycle, _ := HamiltonianPath(petersen, v, adj); cycle != nil {
+					t.Fatalf("petersen graph should have no hamiltonian cycle, %s", cycleString(cycle))
+				}
+			}
+		}
+	}
+}
+
+// Check that a tour starts at start, visits every vertex once and goes back
+func checkTour(t *testing.T, g GraphInterface, tour Path, start string) {
+	names := tour.Names()
+	if len(names) != len(g.Verteces())+1 || names[0] != start || names[len(names)-1] != start {
+		t.Fatalf("%v is not a tour from %s", names, start)
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range names[1:] {
+		if seen[name] {
+			t.Fatalf("%s is visited twice in %v", name, names)
+		}
+		seen[name] = true
+	}
+}
+
+/// create complete graph of cities for test, weights are the distances between points
+func createCityGraph4Test(t *testing.T, points [][2]float64) *UndirectedGraph {
+	g := NewUndirectedGraph("CityGraph")
+	for i := range points {
+		if g.InsertVertex(NewVertex(fmt.Sprintf("c%d", i), points[i])) != nil {
+			t.Error("InsertVertex error")
+		}
+	}
+
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			d := math.Hypot(points[i][0]-points[j][0], points[i][1]-points[j][1])
+			if g.InsertEdgeByName(fmt.Sprintf("c%d", i), fmt.Sprintf("c%d", j), NewEdge(float32(d), UndirectedEdge)) != nil {
+				t.Error("InsertEdge error")
+			}
+		}
+	}
+
+	return g
+}
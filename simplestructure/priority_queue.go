@@ -0,0 +1,95 @@
+package simplestructure
+
+import (
+	"container/heap"
+	"sync"
+)
+
+/**********************************************************************************/
+// define priority queue interface
+/**********************************************************************************/
+
+// min-priority queue: the element with the smallest priority pops first
+type PriorityQueue interface {
+	Push(interface{}, float64)
+	Pop() interface{}
+	Size() int
+}
+
+/**********************************************************************************/
+// define simple priority queue
+/**********************************************************************************/
+
+type priorityItem struct {
+	value    interface{}
+	priority float64
+	seq      int
+}
+
+// heap container for priority items, implements heap.Interface
+type priorityItems []*priorityItem
+
+func (items priorityItems) Len() int { return len(items) }
+
+func (items priorityItems) Less(i, j int) bool {
+	if items[i].priority == items[j].priority {
+		// keep insertion order for equal priorities
+		return items[i].seq < items[j].seq
+	}
+	return items[i].priority < items[j].priority
+}
+
+func (items priorityItems) Swap(i, j int) { items[i], items[j] = items[j], items[i] }
+
+func (items *priorityItems) Push(x interface{}) {
+	*items = append(*items, x.(*priorityItem))
+}
+
+func (items *priorityItems) Pop() interface{} {
+	old := *items
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*items = old[:n-1]
+	return item
+}
+
+type SimplePriorityQueue struct {
+	elements priorityItems
+	counter  int
+	lock     sync.Mutex
+}
+
+func NewSimplePriorityQueue() *SimplePriorityQueue {
+	q := SimplePriorityQueue{}
+	return &q
+}
+
+func (q *SimplePriorityQueue) Push(v interface{}, priority float64) {
+	defer q.lock.Unlock()
+	q.lock.Lock()
+
+	heap.Push(&q.elements, &priorityItem{
+		value:    v,
+		priority: priority,
+		seq:      q.counter,
+	})
+	q.counter++
+}
+
+func (q *SimplePriorityQueue) Pop() interface{} {
+	defer q.lock.Unlock()
+	q.lock.Lock()
+
+	if len(q.elements) == 0 {
+		return nil
+	}
+	return heap.Pop(&q.elements).(*priorityItem).value
+}
+
+func (q *SimplePriorityQueue) Size() int {
+	defer q.lock.Unlock()
+	q.lock.Lock()
+
+	return len(q.elements)
+}
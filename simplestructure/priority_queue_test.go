@@ -0,0 +1,24 @@
+package simplestructure
+
+import "testing"
+
+func Test4PriorityQueue(t *testing.T) {
+	q := NewSimplePriorityQueue()
+	q.Push("c", 3)
+	q.Push("a", 1)
+	q.Push("d", 3)
+	q.Push("b", 2)
+
+	expected := []string{"a", "b", "c", "d"}
+	for _, e := range expected {
+		v := q.Pop()
+		t.Log(v)
+		if v != e {
+			t.Errorf("pop %v, expected %s", v, e)
+		}
+	}
+
+	if q.Size() != 0 || q.Pop() != nil {
+		t.Error("queue should be empty")
+	}
+}